@@ -1,9 +1,9 @@
 package main
 
 import (
+	"context"
 	"flag"
-	"fmt"
-	"log"
+	"log/slog"
 	"os"
 
 	"news-service/internal/config"
@@ -27,18 +27,18 @@ func main() {
 
 	cfg, err := config.LoadDefault()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		fatal("Failed to load config", "error", err)
 	}
 
-	db, err := database.NewPostgresConnection(cfg)
+	db, err := database.NewPostgresConnection(context.Background(), cfg)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		fatal("Failed to connect to database", "error", err)
 	}
 	defer db.Close()
 
 	driver, err := postgres.WithInstance(db, &postgres.Config{})
 	if err != nil {
-		log.Fatalf("Failed to create migrate driver: %v", err)
+		fatal("Failed to create migrate driver", "error", err)
 	}
 
 	m, err := migrate.NewWithDatabaseInstance(
@@ -47,21 +47,26 @@ func main() {
 		driver,
 	)
 	if err != nil {
-		log.Fatalf("Failed to create migrate instance: %v", err)
+		fatal("Failed to create migrate instance", "error", err)
 	}
 
 	switch direction {
 	case "up":
 		if err := m.Up(); err != nil && err != migrate.ErrNoChange {
-			log.Fatalf("Failed to apply migrations: %v", err)
+			fatal("Failed to apply migrations", "error", err)
 		}
-		fmt.Println("Migrations applied successfully")
+		slog.Info("Migrations applied")
 	case "down":
 		if err := m.Down(); err != nil && err != migrate.ErrNoChange {
-			log.Fatalf("Failed to rollback migrations: %v", err)
+			fatal("Failed to rollback migrations", "error", err)
 		}
-		fmt.Println("Migrations rolled back successfully")
+		slog.Info("Migrations rolled back")
 	default:
-		log.Fatalf("Invalid direction: %s. Use 'up' or 'down'", direction)
+		fatal("Invalid direction, use 'up' or 'down'", "direction", direction)
 	}
 }
+
+func fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
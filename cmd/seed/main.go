@@ -2,11 +2,13 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"os"
 	"time"
 
 	"news-service/internal/config"
 	"news-service/internal/domain"
+	"news-service/internal/logger"
 	"news-service/internal/repository/postgres"
 	"news-service/pkg/database"
 )
@@ -14,17 +16,25 @@ import (
 func main() {
 	cfg, err := config.LoadDefault()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		slog.Error("Failed to load config", "error", err)
+		os.Exit(1)
 	}
 
-	db, err := database.NewPostgresConnection(cfg)
+	log, err := logger.New(logger.Config{Level: cfg.Log.Level, Format: cfg.Log.Format}, os.Stdout)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		slog.Error("Failed to create logger", "error", err)
+		os.Exit(1)
 	}
-	defer db.Close()
 
-	repo := postgres.NewNewsRepository(db)
 	ctx := context.Background()
+	db, err := database.NewPostgresConnection(ctx, cfg)
+	if err != nil {
+		log.Error("Failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer db.Close()
+
+	repo := postgres.NewNewsRepository(db, log)
 
 	// Тестовые данные
 	testNews := []*domain.News{
@@ -32,41 +42,51 @@ func main() {
 			Slug:    "first-news",
 			Title:   "Первая новость",
 			Content: "Это содержимое первой новости. Здесь много интересной информации.",
+			Tags:    []string{"общество"},
 		},
 		{
 			Slug:    "breaking-news",
 			Title:   "Срочные новости",
 			Content: "Важная новость, которую все должны знать. Подробности внутри.",
+			Tags:    []string{"общество", "срочно"},
 		},
 		{
 			Slug:    "tech-update",
 			Title:   "Обновление технологий",
 			Content: "Новые технологии изменяют мир. В этой статье рассказываем о последних трендах.",
+			Tags:    []string{"технологии"},
 		},
 		{
 			Slug:    "sports-news",
 			Title:   "Спортивные новости",
 			Content: "Результаты последних спортивных событий и анонсы предстоящих матчей.",
+			Tags:    []string{"спорт"},
 		},
 		{
 			Slug:    "weather-forecast",
 			Title:   "Прогноз погоды",
 			Content: "Погода на завтра и ближайшие дни. Не забудьте взять зонт!",
+			Tags:    []string{"погода"},
 		},
 	}
 
-	log.Println("Начинаем заполнение БД тестовыми данными...")
+	log.Info("Seeding database", "count", len(testNews))
 
 	for _, news := range testNews {
+		news.CreatedBy = "seed"
 		if err := repo.Create(ctx, news); err != nil {
-			log.Printf("Ошибка при создании новости %s: %v", news.Slug, err)
+			log.Error("Failed to create news", "slug", news.Slug, "error", err)
 			continue
 		}
-		log.Printf("Создана новость: %s", news.Title)
+		log.Info("News created", "slug", news.Slug)
+
+		if _, err := repo.SetTags(ctx, news.Slug, news.Tags); err != nil {
+			log.Error("Failed to set news tags", "slug", news.Slug, "error", err)
+		}
 
 		// Небольшая задержка для разных created_at
 		time.Sleep(100 * time.Millisecond)
 	}
 
-	log.Println("Заполнение БД завершено!")
+	log.Info("Seeding completed")
 }
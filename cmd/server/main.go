@@ -1,17 +1,29 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"news-service/internal/auth"
 	"news-service/internal/cache"
 	"news-service/internal/config"
+	"news-service/internal/health"
+	"news-service/internal/lifecycle"
+	"news-service/internal/logger"
+	"news-service/internal/metrics"
+	"news-service/internal/repository"
 	"news-service/internal/repository/postgres"
 	"news-service/internal/service"
+	"news-service/internal/tracing"
+	"news-service/internal/transport/admin"
 	"news-service/internal/transport/grpc"
+	"news-service/internal/transport/http"
 	"news-service/pkg/database"
 )
 
@@ -19,48 +31,209 @@ func main() {
 	// Загрузка конфигурации
 	cfg, err := config.LoadDefault()
 	if err != nil {
-		log.Fatalf("Failed to load config: %v", err)
+		slog.Error("Failed to load config", "error", err)
+		os.Exit(1)
 	}
 
+	// Логгер: уровень и формат из конфигурации, request_id и trace_id из контекста
+	log, err := logger.New(logger.Config{Level: cfg.Log.Level, Format: cfg.Log.Format}, os.Stdout)
+	if err != nil {
+		slog.Error("Failed to create logger", "error", err)
+		os.Exit(1)
+	}
+	slog.SetDefault(log)
+
+	// Сигналы завершения отменяют контекст
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg, log); err != nil {
+		log.Error("Server stopped with error", "error", err)
+		os.Exit(1)
+	}
+	log.Info("Server stopped")
+}
+
+// run запускает сервер и блокируется до сигнала завершения или ошибки запуска.
+// Компоненты регистрируются в lifecycle.Manager по мере создания и
+// останавливаются в обратном порядке: проверки состояния, шлюз, gRPC,
+// фоновые задачи, служебный сервер, кеш, БД, трассировка.
+func run(ctx context.Context, cfg *config.Config, log *slog.Logger) error {
+	// Ключи проверки токенов загружаются до подключения к БД: без них при
+	// включенной аутентификации сервер не запускается
+	var verifier *auth.Verifier
+	if cfg.Auth.Enabled {
+		var err error
+		verifier, err = auth.NewVerifier(auth.VerifierConfig{
+			HS256Secret:        cfg.Auth.HS256Secret,
+			RS256PublicKeyFile: cfg.Auth.RS256PublicKeyFile,
+			JWKSFile:           cfg.Auth.JWKSFile,
+			Issuer:             cfg.Auth.Issuer,
+			Audience:           cfg.Auth.Audience,
+			RoleClaim:          cfg.Auth.RoleClaim,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to configure authentication (set AUTH_HS256_SECRET, AUTH_RS256_PUBLIC_KEY_FILE or AUTH_JWKS_FILE, or AUTH_ENABLED=false): %w", err)
+		}
+	}
+
+	lc := lifecycle.New(cfg.Server.ShutdownTimeout, log)
+	// Останавливает уже созданные компоненты, если запуск прервется до lc.Run
+	defer lc.Shutdown()
+
+	// Трассировка: спаны gRPC, сервиса, кеша и SQL-запросов
+	shutdownTracing, err := tracing.Setup(ctx, tracing.Config{
+		Exporter:     cfg.Tracing.Exporter,
+		ServiceName:  cfg.Tracing.ServiceName,
+		OTLPEndpoint: cfg.Tracing.OTLPEndpoint,
+		OTLPInsecure: cfg.Tracing.OTLPInsecure,
+		SampleRatio:  cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing: %w", err)
+	}
+	lc.OnStop("tracing", shutdownTracing)
+
 	// Подключение к базе данных
-	db, err := database.NewPostgresConnection(cfg)
+	db, err := database.NewPostgresConnection(ctx, cfg)
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	lc.OnStop("database", func(context.Context) error { return db.Close() })
+
+	// Реплики для чтения; без них все запросы идут в primary
+	replicaDBs, err := database.NewReplicaConnections(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to open database replicas: %w", err)
+	}
+	for i, replica := range replicaDBs {
+		lc.OnStop(fmt.Sprintf("database replica %d", i), func(context.Context) error { return replica.Close() })
 	}
-	defer db.Close()
 
 	// Инициализация кеша
 	cacheInstance := cache.New(cfg.Cache.TTL)
-	defer cacheInstance.Stop()
+	lc.OnStop("cache", func(context.Context) error {
+		cacheInstance.Stop()
+		return nil
+	})
 
-	// Инициализация репозитория
-	newsRepo := postgres.NewNewsRepository(db)
+	// Метрики: вызовы gRPC, запросы к БД, кеш и пул соединений
+	serviceMetrics := metrics.New()
+	serviceMetrics.RegisterCache(cacheInstance)
+	serviceMetrics.RegisterDB(db, cfg.Database.DBName)
+	for i, replica := range replicaDBs {
+		serviceMetrics.RegisterDB(replica, fmt.Sprintf("%s_replica_%d", cfg.Database.DBName, i))
+	}
+
+	// Инициализация репозитория: чтение из реплик по кругу, запись в primary.
+	// После записи чтения клиента на время DB_READ_YOUR_WRITES идут в primary.
+	var repoOpts []postgres.Option
+	if len(replicaDBs) > 0 {
+		replicas := postgres.NewReplicaSet(replicaDBs, postgres.ReplicaConfig{
+			CheckInterval:  cfg.Database.ReplicaCheckInterval,
+			CheckTimeout:   cfg.Health.CheckTimeout,
+			ReadYourWrites: cfg.Database.ReadYourWrites,
+			CallerKey:      grpc.CallerKey,
+		}, log)
+		lc.GoWorker("replica checker", replicas.Run)
+		repoOpts = append(repoOpts, postgres.WithReplicas(replicas))
+		log.Info("Routing reads to replicas", "replicas", len(replicaDBs))
+	}
+	newsRepo := repository.Instrument(postgres.NewNewsRepository(db, log, repoOpts...), serviceMetrics.ObserveQuery)
 
 	// Инициализация сервиса
-	newsService := service.NewNewsService(newsRepo, cacheInstance)
+	newsService := service.NewNewsService(newsRepo, cacheInstance, service.WithLogger(log))
 
-	// Инициализация gRPC сервера
-	grpcServer := grpc.NewServer(newsService)
+	// Проверки состояния: готовность для grpc.health.v1 и /readyz
+	healthChecker := health.NewChecker(cfg.Health.CheckInterval, cfg.Health.CheckTimeout, log)
+	healthChecker.Add("postgres", db.PingContext)
+	healthChecker.Add("cache", cacheInstance.Check)
+
+	// Служебный сервер с метриками и проверками состояния. Останавливается
+	// после gRPC, чтобы пробы видели NOT_SERVING на время остановки.
+	if cfg.Server.AdminPort > 0 {
+		adminServer := admin.NewServer(fmt.Sprintf(":%d", cfg.Server.AdminPort))
+		adminServer.Handle("/metrics", serviceMetrics.Handler())
+		adminServer.Handle("/healthz", healthChecker.LiveHandler())
+		adminServer.Handle("/readyz", healthChecker.ReadyHandler())
+
+		log.Info("Starting admin server", "port", cfg.Server.AdminPort)
+		lc.Go("admin server", func() error {
+			return adminServer.Start()
+		})
+		lc.OnStop("admin server", adminServer.Stop)
+	}
+
+	// Фоновые задачи: проверки состояния, очистка корзины и публикация отложенных новостей
+	lc.GoWorker("health checker", healthChecker.Run)
+	lc.GoWorker("trash purger", func(ctx context.Context) {
+		newsService.RunPurger(ctx, cfg.Trash.PurgeInterval, cfg.Trash.Retention)
+	})
+	lc.GoWorker("publish scheduler", func(ctx context.Context) {
+		newsService.RunScheduler(ctx, cfg.Publishing.SchedulerInterval)
+	})
+
+	// Ключ, которым шлюз подтверждает адрес клиента; если он не задан,
+	// создаем случайный на время работы процесса
+	proxyKey := cfg.Server.ProxyKey
+	if proxyKey == "" {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return fmt.Errorf("failed to generate proxy key: %w", err)
+		}
+		proxyKey = hex.EncodeToString(key)
+	}
 
-	// Канал для обработки сигналов завершения
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	// Инициализация gRPC сервера
+	serverOpts := []grpc.Option{
+		grpc.WithLegacyErrorField(cfg.Server.LegacyErrorField),
+		grpc.WithMetrics(serviceMetrics),
+		grpc.WithLogger(log),
+		grpc.WithHealth(healthChecker),
+		grpc.WithProxyKey(proxyKey),
+	}
+	if verifier != nil {
+		serverOpts = append(serverOpts, grpc.WithAuth(verifier))
+	} else {
+		log.Warn("Authentication is disabled")
+	}
+	if cfg.RateLimit.Enabled {
+		methods := make(map[string]grpc.RateLimit, len(cfg.RateLimit.Methods))
+		for method, limit := range cfg.RateLimit.Methods {
+			methods[method] = grpc.RateLimit{RPS: limit.RPS, Burst: limit.Burst}
+		}
+		serverOpts = append(serverOpts, grpc.WithRateLimit(grpc.RateLimitConfig{
+			Default: grpc.RateLimit{RPS: cfg.RateLimit.RPS, Burst: cfg.RateLimit.Burst},
+			Methods: methods,
+			IdleTTL: cfg.RateLimit.IdleTTL,
+		}))
+	}
+	grpcServer := grpc.NewServer(newsService, serverOpts...)
 
-	// Запуск сервера в горутине
-	go func() {
-		address := fmt.Sprintf(":%d", cfg.Server.GRPCPort)
-		log.Printf("Starting gRPC server on port %d", cfg.Server.GRPCPort)
+	log.Info("Starting gRPC server", "port", cfg.Server.GRPCPort)
+	lc.Go("gRPC server", func() error {
+		return grpcServer.Start(fmt.Sprintf(":%d", cfg.Server.GRPCPort))
+	})
+	lc.OnStop("gRPC server", grpcServer.Stop)
 
-		if err := grpcServer.Start(address); err != nil {
-			log.Fatalf("Failed to start gRPC server: %v", err)
+	// REST/JSON шлюз проксирует запросы в gRPC-сервер и останавливается
+	// раньше него, чтобы начатые через шлюз вызовы успели завершиться
+	if cfg.Server.HTTPPort > 0 {
+		gateway, err := http.NewGateway(fmt.Sprintf(":%d", cfg.Server.HTTPPort), fmt.Sprintf("localhost:%d", cfg.Server.GRPCPort), proxyKey)
+		if err != nil {
+			return fmt.Errorf("failed to create HTTP gateway: %w", err)
 		}
-	}()
 
-	// Ожидание сигнала завершения
-	<-sigChan
-	log.Println("Shutting down server...")
+		log.Info("Starting HTTP gateway", "port", cfg.Server.HTTPPort)
+		lc.Go("HTTP gateway", func() error {
+			return gateway.Start()
+		})
+		lc.OnStop("HTTP gateway", gateway.Stop)
+	}
+
+	// Первым делом при остановке сообщаем балансировщику, что новые запросы
+	// принимать не стоит, и даем DrainDelay заметить это до остановки серверов
+	lc.OnDrain("health", cfg.Server.DrainDelay, healthChecker.Shutdown)
 
-	// Graceful shutdown
-	grpcServer.Stop()
-	log.Println("Server stopped")
+	return lc.Run(ctx)
 }
@@ -0,0 +1,48 @@
+package auth
+
+import "context"
+
+// Role - уровень доступа пользователя; каждая следующая роль включает предыдущие
+type Role string
+
+const (
+	RoleReader Role = "reader"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleReader: 1,
+	RoleEditor: 2,
+	RoleAdmin:  3,
+}
+
+// Valid сообщает, что роль известна
+func (r Role) Valid() bool {
+	_, ok := roleRanks[r]
+	return ok
+}
+
+// Allows сообщает, достаточно ли роли r для действия, требующего required
+func (r Role) Allows(required Role) bool {
+	return roleRanks[r] >= roleRanks[required]
+}
+
+// Identity - пользователь, от имени которого выполняется запрос
+type Identity struct {
+	Subject string
+	Role    Role
+}
+
+type identityKey struct{}
+
+// WithIdentity сохраняет пользователя в контексте запроса
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext возвращает пользователя запроса, если он известен
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"news-service/pkg/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// VerifierConfig - источники ключей и требования к токенам.
+// Должен быть задан хотя бы один источник ключей.
+type VerifierConfig struct {
+	// HS256Secret - общий секрет для токенов HS256
+	HS256Secret string
+	// RS256PublicKeyFile - путь к открытому ключу RSA в формате PEM
+	RS256PublicKeyFile string
+	// JWKSFile - путь к локальному файлу JWKS; ключи выбираются по kid
+	JWKSFile string
+	// Issuer и Audience проверяются, если заданы
+	Issuer   string
+	Audience string
+	// RoleClaim - имя claim с ролью пользователя
+	RoleClaim string
+}
+
+// Verifier проверяет bearer-токены JWT
+type Verifier struct {
+	hmacKey   []byte
+	rsaKey    *rsa.PublicKey
+	jwks      map[string]interface{}
+	parser    *jwt.Parser
+	roleClaim string
+}
+
+// NewVerifier загружает ключи из конфигурации
+func NewVerifier(cfg VerifierConfig) (*Verifier, error) {
+	v := &Verifier{roleClaim: cfg.RoleClaim}
+	if v.roleClaim == "" {
+		v.roleClaim = "role"
+	}
+
+	methods := map[string]bool{}
+	if cfg.HS256Secret != "" {
+		v.hmacKey = []byte(cfg.HS256Secret)
+		methods[jwt.SigningMethodHS256.Alg()] = true
+	}
+	if cfg.RS256PublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.RS256PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read RS256 public key: %w", err)
+		}
+		v.rsaKey, err = jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RS256 public key: %w", err)
+		}
+		methods[jwt.SigningMethodRS256.Alg()] = true
+	}
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read JWKS file: %w", err)
+		}
+		v.jwks, err = parseJWKS(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWKS file: %w", err)
+		}
+		for _, key := range v.jwks {
+			methods[algorithmFor(key)] = true
+		}
+	}
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no JWT verification keys configured")
+	}
+
+	validMethods := make([]string, 0, len(methods))
+	for method := range methods {
+		validMethods = append(validMethods, method)
+	}
+	opts := []jwt.ParserOption{jwt.WithValidMethods(validMethods), jwt.WithExpirationRequired()}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	v.parser = jwt.NewParser(opts...)
+
+	return v, nil
+}
+
+// Verify проверяет подпись и claims токена и возвращает пользователя.
+// Ошибки проверки оборачивают errors.ErrUnauthenticated.
+func (v *Verifier) Verify(tokenString string) (Identity, error) {
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(tokenString, claims, v.key); err != nil {
+		return Identity{}, fmt.Errorf("%w: %v", errors.ErrUnauthenticated, err)
+	}
+
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return Identity{}, fmt.Errorf("%w: token has no subject", errors.ErrUnauthenticated)
+	}
+
+	// Пользователь без роли в токене может только читать
+	role := RoleReader
+	if value, ok := claims[v.roleClaim]; ok {
+		name, _ := value.(string)
+		role = Role(name)
+		if !role.Valid() {
+			return Identity{}, fmt.Errorf("%w: unknown role %q", errors.ErrUnauthenticated, name)
+		}
+	}
+
+	return Identity{Subject: subject, Role: role}, nil
+}
+
+// key выбирает ключ проверки: по kid из JWKS, иначе по алгоритму токена
+func (v *Verifier) key(token *jwt.Token) (interface{}, error) {
+	alg := token.Method.Alg()
+	if kid, ok := token.Header["kid"].(string); ok && v.jwks != nil {
+		key, exists := v.jwks[kid]
+		if !exists {
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		if algorithmFor(key) != alg {
+			return nil, fmt.Errorf("key %q can not verify %s tokens", kid, alg)
+		}
+		return key, nil
+	}
+
+	switch {
+	case alg == jwt.SigningMethodHS256.Alg() && v.hmacKey != nil:
+		return v.hmacKey, nil
+	case alg == jwt.SigningMethodRS256.Alg() && v.rsaKey != nil:
+		return v.rsaKey, nil
+	}
+	return nil, fmt.Errorf("no key for %s tokens", alg)
+}
+
+func algorithmFor(key interface{}) string {
+	if _, ok := key.(*rsa.PublicKey); ok {
+		return jwt.SigningMethodRS256.Alg()
+	}
+	return jwt.SigningMethodHS256.Alg()
+}
+
+// jsonWebKey - поля JWK, нужные для ключей RSA и oct
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	K   string `json:"k"`
+}
+
+// parseJWKS разбирает набор ключей; поддерживаются RSA (RS256) и oct (HS256)
+func parseJWKS(data []byte) (map[string]interface{}, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Kid == "" {
+			return nil, fmt.Errorf("key without kid")
+		}
+		switch jwk.Kty {
+		case "RSA":
+			n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: invalid modulus: %w", jwk.Kid, err)
+			}
+			e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: invalid exponent: %w", jwk.Kid, err)
+			}
+			keys[jwk.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		case "oct":
+			k, err := base64.RawURLEncoding.DecodeString(jwk.K)
+			if err != nil {
+				return nil, fmt.Errorf("key %q: invalid secret: %w", jwk.Kid, err)
+			}
+			keys[jwk.Kid] = k
+		default:
+			return nil, fmt.Errorf("key %q: unsupported key type %q", jwk.Kid, jwk.Kty)
+		}
+	}
+	return keys, nil
+}
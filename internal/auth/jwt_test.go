@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"news-service/pkg/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-secret"
+
+func sign(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.MapClaims, kid string) string {
+	t.Helper()
+
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func validClaims(role string) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"sub": "alice",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	if role != "" {
+		claims["role"] = role
+	}
+	return claims
+}
+
+func TestVerifier_HS256(t *testing.T) {
+	v, err := NewVerifier(VerifierConfig{HS256Secret: testSecret})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %v", err)
+	}
+
+	identity, err := v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("editor"), ""))
+	if err != nil {
+		t.Fatalf("Expected valid token, got %v", err)
+	}
+	if identity.Subject != "alice" || identity.Role != RoleEditor {
+		t.Errorf("Expected alice/editor, got %s/%s", identity.Subject, identity.Role)
+	}
+
+	identity, err = v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims(""), ""))
+	if err != nil || identity.Role != RoleReader {
+		t.Errorf("Expected reader role without role claim, got %q, %v", identity.Role, err)
+	}
+}
+
+func TestNewVerifier_RequiresKeys(t *testing.T) {
+	if _, err := NewVerifier(VerifierConfig{RoleClaim: "role"}); err == nil {
+		t.Error("Expected error without verification keys")
+	}
+}
+
+func TestVerifier_RejectsInvalidTokens(t *testing.T) {
+	v, err := NewVerifier(VerifierConfig{HS256Secret: testSecret})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %v", err)
+	}
+
+	expired := validClaims("admin")
+	expired["exp"] = time.Now().Add(-time.Minute).Unix()
+	noExpiry := validClaims("admin")
+	delete(noExpiry, "exp")
+
+	tokens := map[string]string{
+		"expired":      sign(t, jwt.SigningMethodHS256, []byte(testSecret), expired, ""),
+		"no expiry":    sign(t, jwt.SigningMethodHS256, []byte(testSecret), noExpiry, ""),
+		"wrong secret": sign(t, jwt.SigningMethodHS256, []byte("other"), validClaims("admin"), ""),
+		"unknown role": sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("root"), ""),
+		"garbage":      "not-a-token",
+	}
+	for name, token := range tokens {
+		if _, err := v.Verify(token); !stderrors.Is(err, errors.ErrUnauthenticated) {
+			t.Errorf("%s: expected ErrUnauthenticated, got %v", name, err)
+		}
+	}
+}
+
+func TestVerifier_JWKS(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+
+	jwks := fmt.Sprintf(`{"keys": [{"kty": "RSA", "kid": "rsa-1", "n": %q, "e": %q}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, []byte(jwks), 0o600); err != nil {
+		t.Fatalf("Failed to write JWKS: %v", err)
+	}
+
+	v, err := NewVerifier(VerifierConfig{JWKSFile: path})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %v", err)
+	}
+
+	identity, err := v.Verify(sign(t, jwt.SigningMethodRS256, key, validClaims("admin"), "rsa-1"))
+	if err != nil || identity.Role != RoleAdmin {
+		t.Errorf("Expected admin from RS256 token, got %q, %v", identity.Role, err)
+	}
+
+	if _, err := v.Verify(sign(t, jwt.SigningMethodRS256, key, validClaims("admin"), "rsa-2")); err == nil {
+		t.Error("Expected token with unknown kid to be rejected")
+	}
+	if _, err := v.Verify(sign(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims("admin"), "")); err == nil {
+		t.Error("Expected HS256 token to be rejected without HS256 key")
+	}
+}
+
+func TestRole_Allows(t *testing.T) {
+	if !RoleAdmin.Allows(RoleEditor) || !RoleEditor.Allows(RoleReader) {
+		t.Error("Expected higher roles to include lower ones")
+	}
+	if RoleReader.Allows(RoleEditor) || RoleEditor.Allows(RoleAdmin) {
+		t.Error("Expected lower roles to be insufficient")
+	}
+}
@@ -1,15 +1,37 @@
 package cache
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type Cache struct {
-	mu    sync.RWMutex
-	items map[string]CacheItem
-	ttl   time.Duration
-	stop  chan struct{}
+	mu          sync.RWMutex
+	items       map[string]CacheItem
+	generations map[string]uint64
+	ttl         time.Duration
+	stop        chan struct{}
+
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	expired     atomic.Uint64
+	invalidated atomic.Uint64
+}
+
+// Stats - счетчики обращений к кешу с момента создания
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	// Expired - элементы, удаленные по истечении TTL
+	Expired uint64
+	// Invalidated - элементы, удаленные при инвалидации (Invalidate)
+	Invalidated uint64
+	// Items - текущее количество элементов, включая еще не удаленные просроченные
+	Items int
 }
 
 type CacheItem struct {
@@ -19,9 +41,10 @@ type CacheItem struct {
 
 func New(ttl time.Duration) *Cache {
 	c := &Cache{
-		items: make(map[string]CacheItem),
-		ttl:   ttl,
-		stop:  make(chan struct{}),
+		items:       make(map[string]CacheItem),
+		generations: make(map[string]uint64),
+		ttl:         ttl,
+		stop:        make(chan struct{}),
 	}
 
 	// Запуск горутины для очистки просроченных элементов
@@ -36,14 +59,17 @@ func (c *Cache) Get(key string) (interface{}, bool) {
 
 	item, exists := c.items[key]
 	if !exists {
+		c.misses.Add(1)
 		return nil, false
 	}
 
 	// Проверяем, не истек ли срок жизни
 	if time.Now().After(item.ExpiresAt) {
+		c.misses.Add(1)
 		return nil, false
 	}
 
+	c.hits.Add(1)
 	return item.Value, true
 }
 
@@ -64,6 +90,47 @@ func (c *Cache) Delete(key string) {
 	delete(c.items, key)
 }
 
+// Generation возвращает текущее поколение пространства имен.
+// Поколение включается в ключи, чтобы значение, прочитанное из источника до
+// инвалидации и записанное в кеш после нее, никогда больше не было прочитано.
+func (c *Cache) Generation(namespace string) uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.generations[namespace]
+}
+
+// Invalidate переводит пространство имен на новое поколение и удаляет
+// все ключи вида "namespace:..."
+func (c *Cache) Invalidate(namespace string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[namespace]++
+	prefix := namespace + ":"
+	for key := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			delete(c.items, key)
+			c.invalidated.Add(1)
+		}
+	}
+}
+
+// Stats возвращает счетчики обращений и текущий размер кеша
+func (c *Cache) Stats() Stats {
+	c.mu.RLock()
+	items := len(c.items)
+	c.mu.RUnlock()
+
+	return Stats{
+		Hits:        c.hits.Load(),
+		Misses:      c.misses.Load(),
+		Expired:     c.expired.Load(),
+		Invalidated: c.invalidated.Load(),
+		Items:       items,
+	}
+}
+
 func (c *Cache) Clear() {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -75,6 +142,16 @@ func (c *Cache) Stop() {
 	close(c.stop)
 }
 
+// Check сообщает об ошибке, если кеш уже остановлен
+func (c *Cache) Check(ctx context.Context) error {
+	select {
+	case <-c.stop:
+		return errors.New("cache is stopped")
+	default:
+		return nil
+	}
+}
+
 // cleanup удаляет просроченные элементы каждые 5 минут
 func (c *Cache) cleanup() {
 	ticker := time.NewTicker(5 * time.Minute)
@@ -83,16 +160,21 @@ func (c *Cache) cleanup() {
 	for {
 		select {
 		case <-ticker.C:
-			c.mu.Lock()
-			now := time.Now()
-			for key, item := range c.items {
-				if now.After(item.ExpiresAt) {
-					delete(c.items, key)
-				}
-			}
-			c.mu.Unlock()
+			c.removeExpired(time.Now())
 		case <-c.stop:
 			return
 		}
 	}
 }
+
+func (c *Cache) removeExpired(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, item := range c.items {
+		if now.After(item.ExpiresAt) {
+			delete(c.items, key)
+			c.expired.Add(1)
+		}
+	}
+}
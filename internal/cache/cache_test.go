@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"context"
 	"testing"
 	"time"
 )
@@ -116,3 +117,66 @@ func TestCache_ConcurrentAccess(t *testing.T) {
 
 	// Если тест не упал с race condition, значит все OK
 }
+
+func TestCache_Invalidate(t *testing.T) {
+	cache := New(5 * time.Minute)
+	defer cache.Stop()
+
+	before := cache.Generation("list")
+	cache.Set("list:0:1", "stale")
+	cache.Set("listing", "other")
+
+	cache.Invalidate("list")
+
+	if after := cache.Generation("list"); after != before+1 {
+		t.Errorf("Expected generation %d, got %d", before+1, after)
+	}
+	if _, exists := cache.Get("list:0:1"); exists {
+		t.Error("Keys of invalidated namespace should have been deleted")
+	}
+	if _, exists := cache.Get("listing"); !exists {
+		t.Error("Keys of other namespaces should be kept")
+	}
+	if cache.Generation("other") != 0 {
+		t.Error("Generation of other namespaces should not change")
+	}
+}
+
+func TestCache_Stats(t *testing.T) {
+	cache := New(5 * time.Minute)
+	defer cache.Stop()
+
+	cache.Set("list:1", "a")
+	cache.Set("list:2", "b")
+	cache.Set("news:1", "c")
+
+	cache.Get("news:1")
+	cache.Get("news:2")
+	cache.Get("news:3")
+	cache.Invalidate("list")
+	cache.removeExpired(time.Now().Add(time.Hour))
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 2 {
+		t.Errorf("Expected 1 hit and 2 misses, got %d and %d", stats.Hits, stats.Misses)
+	}
+	if stats.Invalidated != 2 || stats.Expired != 1 {
+		t.Errorf("Expected 2 invalidated and 1 expired, got %d and %d", stats.Invalidated, stats.Expired)
+	}
+	if stats.Items != 0 {
+		t.Errorf("Expected empty cache, got %d items", stats.Items)
+	}
+}
+
+func TestCache_Check(t *testing.T) {
+	cache := New(5 * time.Minute)
+
+	if err := cache.Check(context.Background()); err != nil {
+		t.Errorf("Expected running cache to be healthy, got %v", err)
+	}
+
+	cache.Stop()
+	if err := cache.Check(context.Background()); err == nil {
+		t.Error("Expected error for stopped cache")
+	}
+}
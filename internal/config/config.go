@@ -1,6 +1,11 @@
 package config
 
-import "time"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 type Config struct {
 	Database struct {
@@ -10,13 +15,151 @@ type Config struct {
 		Password string `yaml:"password" env:"DB_PASSWORD" env-default:"password"`
 		DBName   string `yaml:"dbname" env:"DB_NAME" env-default:"news_db"`
 		SSLMode  string `yaml:"sslmode" env:"DB_SSLMODE" env-default:"disable"`
+		// SSLRootCert, SSLCert, SSLKey - пути к сертификатам для sslmode=verify-ca/verify-full
+		// и клиентской аутентификации по сертификату
+		SSLRootCert string `yaml:"sslrootcert" env:"DB_SSLROOTCERT"`
+		SSLCert     string `yaml:"sslcert" env:"DB_SSLCERT"`
+		SSLKey      string `yaml:"sslkey" env:"DB_SSLKEY"`
+		// URL - полная строка подключения (postgres://... или key=value); если задана,
+		// параметры подключения выше, таймауты и application_name не используются
+		URL string `yaml:"url" env:"DB_URL"`
+
+		ApplicationName string `yaml:"application_name" env:"DB_APPLICATION_NAME" env-default:"news-service"`
+		// ConnectTimeout ограничивает установку одного соединения
+		ConnectTimeout time.Duration `yaml:"connect_timeout" env:"DB_CONNECT_TIMEOUT" env-default:"5s"`
+		// StatementTimeout - statement_timeout сессии, 0 - без ограничения
+		StatementTimeout time.Duration `yaml:"statement_timeout" env:"DB_STATEMENT_TIMEOUT" env-default:"0"`
+
+		// Пул соединений, см. sql.DB; 0 в MaxOpenConns снимает ограничение
+		MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS" env-default:"25"`
+		MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" env-default:"10"`
+		ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" env-default:"30m"`
+		ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time" env:"DB_CONN_MAX_IDLE_TIME" env-default:"5m"`
+
+		// ConnectAttempts - сколько раз проверять соединение при запуске; между
+		// попытками пауза растет вдвое, начиная с ConnectBackoff
+		ConnectAttempts int           `yaml:"connect_attempts" env:"DB_CONNECT_ATTEMPTS" env-default:"5"`
+		ConnectBackoff  time.Duration `yaml:"connect_backoff" env:"DB_CONNECT_BACKOFF" env-default:"500ms"`
+
+		// ReplicaURLs - строки подключения к репликам для чтения; через запятую в DB_REPLICA_URLS
+		ReplicaURLs          []string      `yaml:"replica_urls" env:"DB_REPLICA_URLS" env-separator:","`
+		ReplicaCheckInterval time.Duration `yaml:"replica_check_interval" env:"DB_REPLICA_CHECK_INTERVAL" env-default:"5s"`
+		// ReadYourWrites - сколько после записи чтения клиента идут в primary, 0 отключает
+		ReadYourWrites time.Duration `yaml:"read_your_writes" env:"DB_READ_YOUR_WRITES" env-default:"5s"`
 	} `yaml:"database"`
 
 	Server struct {
 		GRPCPort int `yaml:"grpc_port" env:"GRPC_PORT" env-default:"8080"`
+		// HTTPPort - порт REST/JSON шлюза, 0 отключает шлюз
+		HTTPPort int `yaml:"http_port" env:"HTTP_PORT" env-default:"8081"`
+		// AdminPort - порт служебного HTTP-сервера (/metrics), 0 отключает его
+		AdminPort int `yaml:"admin_port" env:"ADMIN_PORT" env-default:"9090"`
+		// LegacyErrorField возвращает ошибки строкой в поле error вместо статусов gRPC
+		LegacyErrorField bool `yaml:"legacy_error_field" env:"GRPC_LEGACY_ERROR_FIELD" env-default:"false"`
+		// ProxyKey подтверждает, что x-forwarded-for задан доверенным прокси
+		// (HTTP-шлюзом или внешним прокси); пустой - случайный ключ для встроенного шлюза
+		ProxyKey string `yaml:"proxy_key" env:"GRPC_PROXY_KEY"`
+		// ShutdownTimeout - срок остановки каждого компонента; после него соединения gRPC закрываются принудительно
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-default:"15s"`
+		// DrainDelay - пауза между переходом проверок в NOT_SERVING и остановкой
+		// серверов, за которую балансировщики успевают убрать экземпляр
+		DrainDelay time.Duration `yaml:"drain_delay" env:"SHUTDOWN_DRAIN_DELAY" env-default:"5s"`
 	} `yaml:"server"`
 
 	Cache struct {
 		TTL time.Duration `yaml:"ttl" env:"CACHE_TTL" env-default:"5m"`
 	} `yaml:"cache"`
+
+	Trash struct {
+		// Retention - сколько удаленные новости хранятся в корзине до очистки
+		Retention     time.Duration `yaml:"retention" env:"TRASH_RETENTION" env-default:"720h"`
+		PurgeInterval time.Duration `yaml:"purge_interval" env:"TRASH_PURGE_INTERVAL" env-default:"1h"`
+	} `yaml:"trash"`
+
+	Auth struct {
+		// Enabled = false отключает проверку токенов, например для локальной разработки
+		Enabled bool `yaml:"enabled" env:"AUTH_ENABLED" env-default:"true"`
+		// Ключи проверки подписи: секрет HS256, открытый ключ RS256 (PEM) и/или JWKS
+		HS256Secret        string `yaml:"hs256_secret" env:"AUTH_HS256_SECRET"`
+		RS256PublicKeyFile string `yaml:"rs256_public_key_file" env:"AUTH_RS256_PUBLIC_KEY_FILE"`
+		JWKSFile           string `yaml:"jwks_file" env:"AUTH_JWKS_FILE"`
+		Issuer             string `yaml:"issuer" env:"AUTH_ISSUER"`
+		Audience           string `yaml:"audience" env:"AUTH_AUDIENCE"`
+		RoleClaim          string `yaml:"role_claim" env:"AUTH_ROLE_CLAIM" env-default:"role"`
+	} `yaml:"auth"`
+
+	RateLimit struct {
+		Enabled bool `yaml:"enabled" env:"RATE_LIMIT_ENABLED" env-default:"true"`
+		// RPS и Burst - лимит клиента для методов без своей настройки
+		RPS   float64 `yaml:"rps" env:"RATE_LIMIT_RPS" env-default:"20"`
+		Burst int     `yaml:"burst" env:"RATE_LIMIT_BURST" env-default:"40"`
+		// IdleTTL - через сколько забываются неактивные клиенты
+		IdleTTL time.Duration `yaml:"idle_ttl" env:"RATE_LIMIT_IDLE_TTL" env-default:"10m"`
+		// Methods - лимиты отдельных методов по короткому имени метода
+		Methods MethodRateLimits `yaml:"methods" env:"RATE_LIMIT_METHODS" env-default:"SearchNews=5/10"`
+	} `yaml:"rate_limit"`
+
+	Publishing struct {
+		// SchedulerInterval - как часто проверяются отложенные публикации
+		SchedulerInterval time.Duration `yaml:"scheduler_interval" env:"PUBLISH_SCHEDULER_INTERVAL" env-default:"1m"`
+	} `yaml:"publishing"`
+
+	Health struct {
+		// CheckInterval - как часто проверяются БД и кеш для grpc.health.v1 и /readyz
+		CheckInterval time.Duration `yaml:"check_interval" env:"HEALTH_CHECK_INTERVAL" env-default:"10s"`
+		CheckTimeout  time.Duration `yaml:"check_timeout" env:"HEALTH_CHECK_TIMEOUT" env-default:"2s"`
+	} `yaml:"health"`
+
+	Log struct {
+		// Level - debug, info, warn или error
+		Level string `yaml:"level" env:"LOG_LEVEL" env-default:"info"`
+		// Format - text или json
+		Format string `yaml:"format" env:"LOG_FORMAT" env-default:"text"`
+	} `yaml:"log"`
+
+	Tracing struct {
+		// Exporter - куда отправлять спаны: otlp, stdout или none
+		Exporter     string  `yaml:"exporter" env:"TRACING_EXPORTER" env-default:"none"`
+		ServiceName  string  `yaml:"service_name" env:"TRACING_SERVICE_NAME" env-default:"news-service"`
+		OTLPEndpoint string  `yaml:"otlp_endpoint" env:"TRACING_OTLP_ENDPOINT" env-default:"localhost:4317"`
+		OTLPInsecure bool    `yaml:"otlp_insecure" env:"TRACING_OTLP_INSECURE" env-default:"true"`
+		SampleRatio  float64 `yaml:"sample_ratio" env:"TRACING_SAMPLE_RATIO" env-default:"1"`
+	} `yaml:"tracing"`
+}
+
+// MethodRateLimit - лимит одного метода для каждого клиента
+type MethodRateLimit struct {
+	RPS   float64 `yaml:"rps"`
+	Burst int     `yaml:"burst"`
+}
+
+// MethodRateLimits - лимиты по методам. В переменной окружения задаются
+// строкой "Method=rps/burst,Method=rps/burst".
+type MethodRateLimits map[string]MethodRateLimit
+
+// SetValue разбирает значение переменной окружения (cleanenv.Setter)
+func (m *MethodRateLimits) SetValue(value string) error {
+	limits := MethodRateLimits{}
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		method, limit, ok := strings.Cut(item, "=")
+		rps, burst, ok2 := strings.Cut(limit, "/")
+		if !ok || !ok2 || method == "" {
+			return fmt.Errorf("invalid method rate limit %q, expected Method=rps/burst", item)
+		}
+		parsedRPS, err := strconv.ParseFloat(rps, 64)
+		if err != nil {
+			return fmt.Errorf("invalid rps in %q: %w", item, err)
+		}
+		parsedBurst, err := strconv.Atoi(burst)
+		if err != nil {
+			return fmt.Errorf("invalid burst in %q: %w", item, err)
+		}
+		limits[method] = MethodRateLimit{RPS: parsedRPS, Burst: parsedBurst}
+	}
+	*m = limits
+	return nil
 }
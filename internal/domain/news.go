@@ -2,10 +2,80 @@ package domain
 
 import "time"
 
+// NewsStatus - этап публикации новости
+type NewsStatus string
+
+const (
+	StatusDraft NewsStatus = "draft"
+	// StatusScheduled - новость будет опубликована планировщиком в PublishAt
+	StatusScheduled NewsStatus = "scheduled"
+	StatusPublished NewsStatus = "published"
+	StatusArchived  NewsStatus = "archived"
+)
+
 type News struct {
 	Slug      string    `json:"slug" db:"slug"`
 	Title     string    `json:"title" db:"title"`
 	Content   string    `json:"content" db:"content"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	Version   int64     `json:"version" db:"version"`
+	// DeletedAt заполнено у новостей в корзине
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	// Tags - теги новости в нижнем регистре, по алфавиту
+	Tags   []string   `json:"tags"`
+	Status NewsStatus `json:"status" db:"status"`
+	// PublishAt - время публикации; у черновиков может быть не задано
+	PublishAt *time.Time `json:"publish_at,omitempty" db:"publish_at"`
+	// CreatedBy и UpdatedBy - пользователи, создавший и последним изменивший
+	// новость; пустые, если пользователь неизвестен
+	CreatedBy string `json:"created_by" db:"created_by"`
+	UpdatedBy string `json:"updated_by" db:"updated_by"`
+}
+
+// IsPublic сообщает, видна ли новость читателям в момент now
+func (n *News) IsPublic(now time.Time) bool {
+	return n.DeletedAt == nil && n.Status == StatusPublished &&
+		n.PublishAt != nil && !n.PublishAt.After(now)
+}
+
+// NewsPatch - частичное обновление новости: nil означает "не изменять поле"
+type NewsPatch struct {
+	Title   *string
+	Content *string
+	// ExpectedVersion - ожидаемая текущая версия новости, 0 отключает проверку
+	ExpectedVersion int64
+	// Author записывается в updated_by и в ревизию, созданную обновлением
+	Author string
+}
+
+// IsEmpty сообщает, что патч не изменяет ни одного поля
+func (p NewsPatch) IsEmpty() bool {
+	return p.Title == nil && p.Content == nil
+}
+
+// SearchHit - новость, найденная полнотекстовым поиском
+type SearchHit struct {
+	News *News
+	Rank float64
+	// TitleHighlight и Snippet содержат найденные слова, выделенные тегами <b>
+	TitleHighlight string
+	Snippet        string
+}
+
+// TagCount - тег и количество новостей с ним
+type TagCount struct {
+	Name  string
+	Count int64
+}
+
+// Revision - снимок заголовка и содержимого новости после изменения
+type Revision struct {
+	Slug string
+	// Version - версия новости, которую она получила этим изменением
+	Version   int64
+	Title     string
+	Content   string
+	Author    string
+	CreatedAt time.Time
 }
@@ -0,0 +1,189 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Check проверяет зависимость сервиса; nil означает, что она доступна
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker периодически выполняет проверки зависимостей (БД, кеш) и хранит
+// их последние результаты. Сервис готов принимать запросы, когда все
+// проверки прошли хотя бы раз и с тех пор не падали, а остановка не началась.
+type Checker struct {
+	interval time.Duration
+	timeout  time.Duration
+	logger   *slog.Logger
+
+	mu           sync.RWMutex
+	checks       []namedCheck
+	results      map[string]error
+	ready        bool
+	shuttingDown bool
+	watchers     []func(ready bool)
+}
+
+// NewChecker создает Checker, который выполняет проверки каждые interval,
+// ограничивая каждую из них timeout
+func NewChecker(interval, timeout time.Duration, logger *slog.Logger) *Checker {
+	return &Checker{
+		interval: interval,
+		timeout:  timeout,
+		logger:   logger,
+		results:  make(map[string]error),
+	}
+}
+
+// Add регистрирует проверку; вызывается до Run
+func (c *Checker) Add(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Watch вызывает fn с текущей готовностью и затем при каждом ее изменении.
+// fn выполняется под блокировкой и не должен обращаться к Checker.
+func (c *Checker) Watch(fn func(ready bool)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.watchers = append(c.watchers, fn)
+	fn(c.ready)
+}
+
+// Run выполняет проверки сразу и затем каждые interval до отмены ctx
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckNow(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckNow выполняет все проверки и обновляет готовность
+func (c *Checker) CheckNow(ctx context.Context) {
+	c.mu.RLock()
+	checks := c.checks
+	c.mu.RUnlock()
+
+	results := make(map[string]error, len(checks))
+	for _, nc := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		results[nc.name] = nc.check(checkCtx)
+		cancel()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, err := range results {
+		prev, checked := c.results[name]
+		switch {
+		case err != nil && (!checked || prev == nil):
+			c.logger.WarnContext(ctx, "Health check failed", "check", name, "error", err)
+		case err == nil && checked && prev != nil:
+			c.logger.InfoContext(ctx, "Health check recovered", "check", name)
+		}
+		c.results[name] = err
+	}
+	c.update()
+}
+
+// Shutdown навсегда переводит сервис в состояние "не готов", чтобы балансировщик
+// перестал направлять новые запросы до остановки серверов
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shuttingDown = true
+	c.update()
+}
+
+// Ready сообщает, готов ли сервис принимать запросы
+func (c *Checker) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ready
+}
+
+// update пересчитывает готовность и оповещает подписчиков; вызывается под c.mu
+func (c *Checker) update() {
+	ready := !c.shuttingDown
+	for _, nc := range c.checks {
+		if err, checked := c.results[nc.name]; !checked || err != nil {
+			ready = false
+		}
+	}
+	if ready == c.ready {
+		return
+	}
+
+	c.ready = ready
+	for _, fn := range c.watchers {
+		fn(ready)
+	}
+}
+
+// LiveHandler отвечает 200, пока процесс работает (проверка /healthz)
+func (c *Checker) LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, report{Status: "ok"})
+	})
+}
+
+// ReadyHandler отвечает 200, если сервис готов, и 503 с результатами
+// проверок в противном случае (проверка /readyz)
+func (c *Checker) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.mu.RLock()
+		rep := report{Status: "ok", Checks: make(map[string]string, len(c.checks))}
+		for _, nc := range c.checks {
+			err, checked := c.results[nc.name]
+			switch {
+			case !checked:
+				rep.Checks[nc.name] = "pending"
+			case err != nil:
+				rep.Checks[nc.name] = err.Error()
+			default:
+				rep.Checks[nc.name] = "ok"
+			}
+		}
+		code := http.StatusOK
+		if c.shuttingDown {
+			rep.Status = "shutting down"
+			code = http.StatusServiceUnavailable
+		} else if !c.ready {
+			rep.Status = "unavailable"
+			code = http.StatusServiceUnavailable
+		}
+		c.mu.RUnlock()
+
+		writeJSON(w, code, rep)
+	})
+}
+
+type report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, code int, rep report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(rep)
+}
@@ -0,0 +1,99 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestChecker() *Checker {
+	return NewChecker(time.Minute, time.Second, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestChecker_ReadyAfterChecksPass(t *testing.T) {
+	c := newTestChecker()
+	var dbErr error
+	c.Add("postgres", func(ctx context.Context) error { return dbErr })
+
+	var states []bool
+	c.Watch(func(ready bool) { states = append(states, ready) })
+
+	if c.Ready() {
+		t.Error("Expected checker to be not ready before the first check")
+	}
+
+	c.CheckNow(context.Background())
+	if !c.Ready() {
+		t.Error("Expected checker to be ready after successful check")
+	}
+
+	dbErr = errors.New("connection refused")
+	c.CheckNow(context.Background())
+	if c.Ready() {
+		t.Error("Expected checker to be not ready while postgres is unreachable")
+	}
+
+	dbErr = nil
+	c.CheckNow(context.Background())
+	c.Shutdown()
+	c.CheckNow(context.Background())
+	if c.Ready() {
+		t.Error("Expected checker to stay not ready after shutdown")
+	}
+
+	want := []bool{false, true, false, true, false}
+	if len(states) != len(want) {
+		t.Fatalf("Expected transitions %v, got %v", want, states)
+	}
+	for i := range want {
+		if states[i] != want[i] {
+			t.Fatalf("Expected transitions %v, got %v", want, states)
+		}
+	}
+}
+
+func TestChecker_CheckTimeout(t *testing.T) {
+	c := NewChecker(time.Minute, 10*time.Millisecond, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	c.Add("postgres", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	c.CheckNow(context.Background())
+
+	if c.Ready() {
+		t.Error("Expected hanging check to fail by timeout")
+	}
+}
+
+func TestChecker_Handlers(t *testing.T) {
+	c := newTestChecker()
+	c.Add("postgres", func(ctx context.Context) error { return errors.New("connection refused") })
+	c.Add("cache", func(ctx context.Context) error { return nil })
+	c.CheckNow(context.Background())
+
+	rec := httptest.NewRecorder()
+	c.LiveHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected /healthz to return 200, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	c.ReadyHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected /readyz to return 503, got %d", rec.Code)
+	}
+	var rep report
+	if err := json.Unmarshal(rec.Body.Bytes(), &rep); err != nil {
+		t.Fatalf("Expected JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	if rep.Status != "unavailable" || rep.Checks["postgres"] != "connection refused" || rep.Checks["cache"] != "ok" {
+		t.Errorf("Unexpected report: %+v", rep)
+	}
+}
@@ -0,0 +1,148 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+type stopHook struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// Manager запускает долгоживущие компоненты (серверы, фоновые задачи) и
+// останавливает их в порядке, обратном регистрации, как defer. Каждая
+// остановка получает собственный срок shutdownTimeout: компонент, исчерпавший
+// свой срок, не лишает времени следующие, и, например, фоновые задачи успевают
+// завершиться до закрытия пула БД.
+type Manager struct {
+	shutdownTimeout time.Duration
+	logger          *slog.Logger
+
+	mu    sync.Mutex
+	hooks []stopHook
+	errs  chan error
+
+	shutdownOnce sync.Once
+	shutdownErr  error
+}
+
+// New создает Manager; shutdownTimeout - срок на остановку одного компонента
+func New(shutdownTimeout time.Duration, logger *slog.Logger) *Manager {
+	return &Manager{
+		shutdownTimeout: shutdownTimeout,
+		logger:          logger,
+		errs:            make(chan error, 1),
+	}
+}
+
+// Go запускает run в отдельной горутине. Ошибка run (например, порт занят)
+// считается ошибкой запуска: Run начинает остановку и возвращает ее.
+func (m *Manager) Go(name string, run func() error) {
+	go func() {
+		if err := run(); err != nil {
+			select {
+			case m.errs <- fmt.Errorf("%s: %w", name, err):
+			default:
+				// Остановка уже началась из-за другой ошибки
+				m.logger.Error("Component failed", "component", name, "error", err)
+			}
+		}
+	}()
+}
+
+// GoWorker запускает фоновую задачу с собственным контекстом. При остановке
+// контекст отменяется, и Manager дожидается выхода из run.
+func (m *Manager) GoWorker(name string, run func(ctx context.Context)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run(ctx)
+	}()
+
+	m.OnStop(name, func(stopCtx context.Context) error {
+		cancel()
+		select {
+		case <-done:
+			return nil
+		case <-stopCtx.Done():
+			return stopCtx.Err()
+		}
+	})
+}
+
+// OnStop регистрирует остановку компонента
+func (m *Manager) OnStop(name string, stop func(ctx context.Context) error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks = append(m.hooks, stopHook{name: name, stop: stop})
+}
+
+// OnDrain регистрирует вывод сервиса из балансировки: при остановке вызывается
+// drain (например, проверки состояния переходят в NOT_SERVING), затем Manager
+// ждет delay, чтобы балансировщики заметили это и перестали присылать новые
+// запросы, и только потом останавливает компоненты, зарегистрированные раньше.
+func (m *Manager) OnDrain(name string, delay time.Duration, drain func()) {
+	m.OnStop(name, func(ctx context.Context) error {
+		drain()
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// Run ждет отмены ctx (сигнал завершения) или ошибки компонента, затем
+// останавливает все компоненты. Возвращает ошибку запуска и ошибки остановки.
+func (m *Manager) Run(ctx context.Context) error {
+	var runErr error
+	select {
+	case <-ctx.Done():
+		m.logger.Info("Shutting down")
+	case runErr = <-m.errs:
+		m.logger.Error("Component failed, shutting down", "error", runErr)
+	}
+
+	return errors.Join(runErr, m.Shutdown())
+}
+
+// Shutdown останавливает компоненты в порядке, обратном регистрации. Повторные
+// вызовы возвращают результат первого, поэтому Shutdown можно отложить через
+// defer на случай ошибки до Run.
+func (m *Manager) Shutdown() error {
+	m.shutdownOnce.Do(func() {
+		m.mu.Lock()
+		hooks := m.hooks
+		m.mu.Unlock()
+
+		var errs []error
+		for i := len(hooks) - 1; i >= 0; i-- {
+			hook := hooks[i]
+			start := time.Now()
+			ctx, cancel := context.WithTimeout(context.Background(), m.shutdownTimeout)
+			err := hook.stop(ctx)
+			cancel()
+			if err != nil {
+				m.logger.Error("Failed to stop component", "component", hook.name, "error", err)
+				errs = append(errs, fmt.Errorf("stop %s: %w", hook.name, err))
+				continue
+			}
+			m.logger.Debug("Component stopped", "component", hook.name, "duration", time.Since(start))
+		}
+		m.shutdownErr = errors.Join(errs...)
+	})
+	return m.shutdownErr
+}
@@ -0,0 +1,142 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestManager(timeout time.Duration) *Manager {
+	return New(timeout, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func TestManager_StopsInReverseOrder(t *testing.T) {
+	m := newTestManager(time.Second)
+	var stopped []string
+	for _, name := range []string{"database", "cache", "grpc", "health"} {
+		name := name
+		m.OnStop(name, func(ctx context.Context) error {
+			stopped = append(stopped, name)
+			return nil
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := m.Run(ctx); err != nil {
+		t.Fatalf("Expected clean shutdown, got %v", err)
+	}
+
+	if got := strings.Join(stopped, ","); got != "health,grpc,cache,database" {
+		t.Errorf("Expected reverse registration order, got %s", got)
+	}
+}
+
+func TestManager_StartupErrorTriggersShutdown(t *testing.T) {
+	m := newTestManager(time.Second)
+	closed := false
+	m.OnStop("database", func(ctx context.Context) error {
+		closed = true
+		return nil
+	})
+	m.Go("grpc", func() error { return errors.New("address already in use") })
+
+	err := m.Run(context.Background())
+
+	if err == nil || !strings.Contains(err.Error(), "grpc: address already in use") {
+		t.Errorf("Expected startup error to be returned, got %v", err)
+	}
+	if !closed {
+		t.Error("Expected database to be closed after startup error")
+	}
+}
+
+func TestManager_ShutdownDeadline(t *testing.T) {
+	m := newTestManager(20 * time.Millisecond)
+	m.OnStop("database", func(ctx context.Context) error { return nil })
+	m.OnStop("grpc", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	start := time.Now()
+	err := m.Shutdown()
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline error from stuck component, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected shutdown to respect the deadline, took %v", elapsed)
+	}
+	if again := m.Shutdown(); again != err {
+		t.Errorf("Expected repeated Shutdown to return the first result, got %v", again)
+	}
+}
+
+func TestManager_GoWorkerWaitsForExit(t *testing.T) {
+	m := newTestManager(time.Second)
+	exited := false
+	m.GoWorker("scheduler", func(ctx context.Context) {
+		<-ctx.Done()
+		exited = true
+	})
+
+	if err := m.Shutdown(); err != nil {
+		t.Fatalf("Expected clean shutdown, got %v", err)
+	}
+	if !exited {
+		t.Error("Expected Shutdown to wait for the worker to exit")
+	}
+}
+
+func TestManager_EachHookGetsItsOwnDeadline(t *testing.T) {
+	m := newTestManager(50 * time.Millisecond)
+	var stopped []string
+	m.OnStop("database", func(ctx context.Context) error {
+		stopped = append(stopped, "database")
+		return nil
+	})
+	m.GoWorker("scheduler", func(ctx context.Context) {
+		<-ctx.Done()
+		// Задача завершается не сразу, но укладывается в свой срок
+		time.Sleep(20 * time.Millisecond)
+		stopped = append(stopped, "scheduler")
+	})
+	m.OnStop("grpc", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	err := m.Shutdown()
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline error from stuck component, got %v", err)
+	}
+	if got := strings.Join(stopped, ","); got != "scheduler,database" {
+		t.Errorf("Expected worker to finish before database closes, got %s", got)
+	}
+}
+
+func TestManager_DrainWaitsBeforeNextHooks(t *testing.T) {
+	m := newTestManager(time.Second)
+	var drainedAt, stoppedAt time.Time
+	m.OnStop("grpc", func(ctx context.Context) error {
+		stoppedAt = time.Now()
+		return nil
+	})
+	m.OnDrain("health", 30*time.Millisecond, func() { drainedAt = time.Now() })
+
+	if err := m.Shutdown(); err != nil {
+		t.Fatalf("Expected clean shutdown, got %v", err)
+	}
+	if drainedAt.IsZero() {
+		t.Fatal("Expected drain to be called")
+	}
+	if gap := stoppedAt.Sub(drainedAt); gap < 30*time.Millisecond {
+		t.Errorf("Expected gRPC to stop after the drain delay, stopped after %v", gap)
+	}
+}
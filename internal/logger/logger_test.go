@@ -0,0 +1,37 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestNew_AddsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	log, err := New(Config{Level: "info", Format: FormatJSON}, &buf)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	log.With("component", "test").InfoContext(ctx, "Handled", "method", "GetNews")
+	log.DebugContext(ctx, "Hidden below info level")
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("Expected exactly one JSON line, got %q: %v", buf.String(), err)
+	}
+	if line["request_id"] != "req-1" || line["method"] != "GetNews" || line["component"] != "test" {
+		t.Errorf("Unexpected log line: %v", line)
+	}
+}
+
+func TestNew_RejectsInvalidConfig(t *testing.T) {
+	if _, err := New(Config{Level: "verbose"}, &bytes.Buffer{}); err == nil {
+		t.Error("Expected invalid level to be rejected")
+	}
+	if _, err := New(Config{Level: "info", Format: "xml"}, &bytes.Buffer{}); err == nil {
+		t.Error("Expected invalid format to be rejected")
+	}
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"database/sql"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"news-service/internal/cache"
+	"news-service/pkg/errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/codes"
+)
+
+const namespace = "news"
+
+// Metrics хранит метрики сервиса в собственном реестре Prometheus
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcHandled    *prometheus.CounterVec
+	rpcDuration   *prometheus.HistogramVec
+	queryDuration *prometheus.HistogramVec
+}
+
+// New создает реестр с метриками рантайма Go и процесса
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "grpc_requests_total",
+			Help:      "Number of completed RPCs by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "grpc_request_duration_seconds",
+			Help:      "RPC latency by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "repository_query_duration_seconds",
+			Help:      "Repository call latency by operation and result.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation", "result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcHandled,
+		m.rpcDuration,
+		m.queryDuration,
+	)
+	return m
+}
+
+// Handler отдает метрики в текстовом формате Prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// ObserveRPC учитывает завершенный вызов gRPC; fullMethod вида /news.NewsService/GetNews
+func (m *Metrics) ObserveRPC(fullMethod string, code codes.Code, duration time.Duration) {
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	m.rpcHandled.WithLabelValues(method, code.String()).Inc()
+	m.rpcDuration.WithLabelValues(method).Observe(duration.Seconds())
+}
+
+// ObserveQuery учитывает вызов репозитория, см. repository.QueryObserver
+func (m *Metrics) ObserveQuery(operation string, duration time.Duration, err error) {
+	m.queryDuration.WithLabelValues(operation, queryResult(err)).Observe(duration.Seconds())
+}
+
+// queryResult отделяет ожидаемые ответы (нет записи, конфликт версий) от сбоев
+func queryResult(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case stderrors.Is(err, errors.ErrNewsNotFound), stderrors.Is(err, errors.ErrRevisionNotFound):
+		return "not_found"
+	case stderrors.Is(err, errors.ErrConflict), stderrors.Is(err, errors.ErrDuplicateSlug):
+		return "conflict"
+	default:
+		return "error"
+	}
+}
+
+// RegisterCache публикует счетчики кеша; значения читаются при каждом сборе метрик
+func (m *Metrics) RegisterCache(c *cache.Cache) {
+	counter := func(name, help string, value func(cache.Stats) uint64, labels prometheus.Labels) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "cache",
+			Name:        name,
+			Help:        help,
+			ConstLabels: labels,
+		}, func() float64 { return float64(value(c.Stats())) })
+	}
+
+	m.registry.MustRegister(
+		counter("hits_total", "Number of cache hits.",
+			func(s cache.Stats) uint64 { return s.Hits }, nil),
+		counter("misses_total", "Number of cache misses, including expired items.",
+			func(s cache.Stats) uint64 { return s.Misses }, nil),
+		counter("evictions_total", "Number of items removed from the cache by reason.",
+			func(s cache.Stats) uint64 { return s.Expired }, prometheus.Labels{"reason": "expired"}),
+		counter("evictions_total", "Number of items removed from the cache by reason.",
+			func(s cache.Stats) uint64 { return s.Invalidated }, prometheus.Labels{"reason": "invalidated"}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "items",
+			Help:      "Number of items currently stored in the cache.",
+		}, func() float64 { return float64(c.Stats().Items) }),
+	)
+}
+
+// RegisterDB публикует статистику пула соединений (go_sql_* с меткой db_name)
+func (m *Metrics) RegisterDB(db *sql.DB, name string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
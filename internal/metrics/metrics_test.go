@@ -0,0 +1,54 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"news-service/internal/cache"
+	"news-service/pkg/errors"
+
+	"google.golang.org/grpc/codes"
+)
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+	return string(body)
+}
+
+func TestMetrics_Exposition(t *testing.T) {
+	m := New()
+	c := cache.New(time.Minute)
+	defer c.Stop()
+	m.RegisterCache(c)
+
+	c.Set("news:1", "a")
+	c.Get("news:1")
+	c.Get("news:2")
+	m.ObserveRPC("/news.NewsService/GetNews", codes.NotFound, 10*time.Millisecond)
+	m.ObserveQuery("GetBySlug", time.Millisecond, fmt.Errorf("wrapped: %w", errors.ErrNewsNotFound))
+	m.ObserveQuery("GetList", time.Millisecond, fmt.Errorf("connection refused"))
+
+	out := scrape(t, m)
+	for _, want := range []string{
+		`news_grpc_requests_total{code="NotFound",method="GetNews"} 1`,
+		`news_grpc_request_duration_seconds_count{method="GetNews"} 1`,
+		`news_repository_query_duration_seconds_count{operation="GetBySlug",result="not_found"} 1`,
+		`news_repository_query_duration_seconds_count{operation="GetList",result="error"} 1`,
+		`news_cache_hits_total 1`,
+		`news_cache_misses_total 1`,
+		`news_cache_evictions_total{reason="expired"} 0`,
+		`news_cache_items 1`,
+		`go_goroutines`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected metrics to contain %q", want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"news-service/internal/domain"
+)
+
+// QueryObserver получает имя метода репозитория, длительность вызова и его ошибку
+type QueryObserver func(operation string, duration time.Duration, err error)
+
+type instrumented struct {
+	next    NewsRepository
+	observe QueryObserver
+}
+
+// Instrument оборачивает репозиторий так, что каждый вызов передается в observe
+func Instrument(repo NewsRepository, observe QueryObserver) NewsRepository {
+	return &instrumented{next: repo, observe: observe}
+}
+
+func (r *instrumented) track(operation string, start time.Time, err error) {
+	r.observe(operation, time.Since(start), err)
+}
+
+func (r *instrumented) Create(ctx context.Context, news *domain.News) (err error) {
+	defer func(start time.Time) { r.track("Create", start, err) }(time.Now())
+	return r.next.Create(ctx, news)
+}
+
+func (r *instrumented) GetBySlug(ctx context.Context, slug string) (news *domain.News, err error) {
+	defer func(start time.Time) { r.track("GetBySlug", start, err) }(time.Now())
+	return r.next.GetBySlug(ctx, slug)
+}
+
+func (r *instrumented) GetList(ctx context.Context, opts ListOptions) (list []*domain.News, total int64, err error) {
+	defer func(start time.Time) { r.track("GetList", start, err) }(time.Now())
+	return r.next.GetList(ctx, opts)
+}
+
+func (r *instrumented) Update(ctx context.Context, slug string, patch domain.NewsPatch) (news *domain.News, err error) {
+	defer func(start time.Time) { r.track("Update", start, err) }(time.Now())
+	return r.next.Update(ctx, slug, patch)
+}
+
+func (r *instrumented) Delete(ctx context.Context, slug string, expectedVersion int64, updatedBy string) (err error) {
+	defer func(start time.Time) { r.track("Delete", start, err) }(time.Now())
+	return r.next.Delete(ctx, slug, expectedVersion, updatedBy)
+}
+
+func (r *instrumented) Restore(ctx context.Context, slug string, updatedBy string) (news *domain.News, err error) {
+	defer func(start time.Time) { r.track("Restore", start, err) }(time.Now())
+	return r.next.Restore(ctx, slug, updatedBy)
+}
+
+func (r *instrumented) ListDeleted(ctx context.Context, offset, limit int) (list []*domain.News, total int64, err error) {
+	defer func(start time.Time) { r.track("ListDeleted", start, err) }(time.Now())
+	return r.next.ListDeleted(ctx, offset, limit)
+}
+
+func (r *instrumented) Purge(ctx context.Context, deletedBefore time.Time) (purged int64, err error) {
+	defer func(start time.Time) { r.track("Purge", start, err) }(time.Now())
+	return r.next.Purge(ctx, deletedBefore)
+}
+
+func (r *instrumented) Search(ctx context.Context, query string, offset, limit int) (hits []*domain.SearchHit, total int64, err error) {
+	defer func(start time.Time) { r.track("Search", start, err) }(time.Now())
+	return r.next.Search(ctx, query, offset, limit)
+}
+
+func (r *instrumented) Rename(ctx context.Context, slug, newSlug string, expectedVersion int64, updatedBy string) (news *domain.News, err error) {
+	defer func(start time.Time) { r.track("Rename", start, err) }(time.Now())
+	return r.next.Rename(ctx, slug, newSlug, expectedVersion, updatedBy)
+}
+
+func (r *instrumented) SetTags(ctx context.Context, slug string, tags []string) (news *domain.News, err error) {
+	defer func(start time.Time) { r.track("SetTags", start, err) }(time.Now())
+	return r.next.SetTags(ctx, slug, tags)
+}
+
+func (r *instrumented) SetStatus(ctx context.Context, slug string, status domain.NewsStatus, publishAt *time.Time, expectedVersion int64, updatedBy string) (news *domain.News, err error) {
+	defer func(start time.Time) { r.track("SetStatus", start, err) }(time.Now())
+	return r.next.SetStatus(ctx, slug, status, publishAt, expectedVersion, updatedBy)
+}
+
+func (r *instrumented) PublishDue(ctx context.Context, now time.Time) (slugs []string, err error) {
+	defer func(start time.Time) { r.track("PublishDue", start, err) }(time.Now())
+	return r.next.PublishDue(ctx, now)
+}
+
+func (r *instrumented) ListRevisions(ctx context.Context, slug string, offset, limit int) (revisions []*domain.Revision, total int64, err error) {
+	defer func(start time.Time) { r.track("ListRevisions", start, err) }(time.Now())
+	return r.next.ListRevisions(ctx, slug, offset, limit)
+}
+
+func (r *instrumented) GetRevision(ctx context.Context, slug string, version int64) (revision *domain.Revision, err error) {
+	defer func(start time.Time) { r.track("GetRevision", start, err) }(time.Now())
+	return r.next.GetRevision(ctx, slug, version)
+}
+
+func (r *instrumented) ListTags(ctx context.Context) (tags []domain.TagCount, err error) {
+	defer func(start time.Time) { r.track("ListTags", start, err) }(time.Now())
+	return r.next.ListTags(ctx)
+}
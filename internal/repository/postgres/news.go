@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"news-service/internal/domain"
@@ -15,51 +17,91 @@ import (
 )
 
 type newsRepository struct {
-	db *sql.DB
+	db       *sql.DB
+	replicas *ReplicaSet
+	logger   *slog.Logger
 }
 
-func NewNewsRepository(db *sql.DB) repository.NewsRepository {
-	return &newsRepository{db: db}
+// Option настраивает репозиторий
+type Option func(*newsRepository)
+
+// NewNewsRepository создает репозиторий поверх primary db; logger получает
+// запросы на уровне debug
+func NewNewsRepository(db *sql.DB, logger *slog.Logger, opts ...Option) repository.NewsRepository {
+	r := &newsRepository{db: db, logger: logger}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
 func (r *newsRepository) Create(ctx context.Context, news *domain.News) error {
+	// Slug, занятый алиасом переименованной новости, считается занятым
 	query := `
-		INSERT INTO news (slug, title, content, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO news (slug, title, content, created_at, updated_at, status, publish_at, created_by, updated_by)
+		SELECT $1::VARCHAR, $2::VARCHAR, $3::TEXT, $4::TIMESTAMP, $5::TIMESTAMP, $6::VARCHAR, $7::TIMESTAMP, $8::VARCHAR, $8::VARCHAR
+		WHERE NOT EXISTS (SELECT 1 FROM news_slug_aliases WHERE old_slug = $1)
 	`
 
 	now := time.Now()
 	news.CreatedAt = now
 	news.UpdatedAt = now
+	news.Version = 1
+	news.UpdatedBy = news.CreatedBy
+	// Без явного статуса новость публикуется сразу, как до появления черновиков
+	if news.Status == "" {
+		news.Status = domain.StatusPublished
+	}
+	if news.Status == domain.StatusPublished && news.PublishAt == nil {
+		news.PublishAt = &now
+	}
 
-	_, err := r.db.ExecContext(ctx, query, news.Slug, news.Title, news.Content, news.CreatedAt, news.UpdatedAt)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := r.execContext(ctx, tx, "INSERT news", query, news.Slug, news.Title, news.Content,
+		news.CreatedAt, news.UpdatedAt, news.Status, news.PublishAt, news.CreatedBy)
 	if err != nil {
 		// Проверяем на дубликат по первичному ключу
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		if isUniqueViolation(err) {
 			return errors.ErrDuplicateSlug
 		}
 		return fmt.Errorf("failed to create news: %w", err)
 	}
 
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errors.ErrDuplicateSlug
+	}
+
+	if err := r.insertRevision(ctx, tx, news); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit news: %w", err)
+	}
+	r.replicas.pin(ctx)
+
 	return nil
 }
 
 func (r *newsRepository) GetBySlug(ctx context.Context, slug string) (*domain.News, error) {
 	query := `
-		SELECT slug, title, content, created_at, updated_at
-		FROM news
-		WHERE slug = $1
+		SELECT ` + newsColumns + ` FROM news WHERE slug = $1 AND deleted_at IS NULL
+		UNION ALL
+		SELECT ` + newsColumns + ` FROM news
+		WHERE slug = (SELECT slug FROM news_slug_aliases WHERE old_slug = $1) AND deleted_at IS NULL
+		LIMIT 1
 	`
 
-	news := &domain.News{}
-	err := r.db.QueryRowContext(ctx, query, slug).Scan(
-		&news.Slug,
-		&news.Title,
-		&news.Content,
-		&news.CreatedAt,
-		&news.UpdatedAt,
-	)
-
+	news, err := scanNews(r.queryRowContext(ctx, r.reader(ctx), "SELECT news", query, slug))
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, errors.ErrNewsNotFound
@@ -70,90 +112,766 @@ func (r *newsRepository) GetBySlug(ctx context.Context, slug string) (*domain.Ne
 	return news, nil
 }
 
-func (r *newsRepository) GetList(ctx context.Context, offset, limit int) ([]*domain.News, int64, error) {
-	// Получаем общее количество записей
-	countQuery := `SELECT COUNT(*) FROM news`
+// sortColumns - белый список колонок сортировки: в SQL попадают только эти имена
+var sortColumns = map[repository.SortField]string{
+	"":                         "created_at",
+	repository.SortByCreatedAt: "created_at",
+	repository.SortByUpdatedAt: "updated_at",
+	repository.SortByTitle:     "title",
+}
+
+func (r *newsRepository) GetList(ctx context.Context, opts repository.ListOptions) ([]*domain.News, int64, error) {
+	column, ok := sortColumns[opts.Sort.Field]
+	if !ok {
+		return nil, 0, fmt.Errorf("unsupported sort field %q", opts.Sort.Field)
+	}
+
+	var args queryArgs
+	conditions := listConditions(opts.Filter, &args)
+
+	db := r.reader(ctx)
+
+	// Общее количество считаем только по запросу: COUNT(*) проходит всю таблицу
 	var total int64
-	err := r.db.QueryRowContext(ctx, countQuery).Scan(&total)
+	if opts.WithTotal {
+		countQuery := `SELECT COUNT(*) FROM news WHERE ` + strings.Join(conditions, " AND ")
+		if err := r.queryRowContext(ctx, db, "SELECT COUNT news", countQuery, args...).Scan(&total); err != nil {
+			return nil, 0, fmt.Errorf("failed to get news count: %w", err)
+		}
+	}
+
+	direction, comparison := "DESC", "<"
+	if opts.Sort.Ascending {
+		direction, comparison = "ASC", ">"
+	}
+
+	// Keyset-пагинация по (column, slug) опирается на индексы idx_news_created_at,
+	// idx_news_updated_at и idx_news_title
+	if opts.After != nil {
+		var value interface{} = opts.After.Time
+		if column == "title" {
+			value = opts.After.Title
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s, slug) %s (%s, %s)",
+			column, comparison, args.add(value), args.add(opts.After.Slug)))
+	}
+
+	query := `SELECT ` + newsColumns + ` FROM news WHERE ` + strings.Join(conditions, " AND ") +
+		fmt.Sprintf(` ORDER BY %s %s, slug %s LIMIT %s`, column, direction, direction, args.add(opts.Limit))
+
+	if opts.After == nil && opts.Offset > 0 {
+		query += ` OFFSET ` + args.add(opts.Offset)
+	}
+
+	newsList, err := r.queryNews(ctx, db, query, args...)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get news count: %w", err)
+		return nil, 0, fmt.Errorf("failed to get news list: %w", err)
+	}
+
+	return newsList, total, nil
+}
+
+// listConditions строит условия WHERE по фильтру; значения передаются параметрами
+func listConditions(filter repository.ListFilter, args *queryArgs) []string {
+	conditions := []string{"deleted_at IS NULL"}
+
+	if !filter.IncludeUnpublished {
+		conditions = append(conditions, "status = 'published' AND publish_at <= "+args.add(time.Now()))
+	}
+
+	if !filter.CreatedFrom.IsZero() {
+		conditions = append(conditions, "created_at >= "+args.add(filter.CreatedFrom))
+	}
+	if !filter.CreatedTo.IsZero() {
+		conditions = append(conditions, "created_at < "+args.add(filter.CreatedTo))
+	}
+	if !filter.UpdatedFrom.IsZero() {
+		conditions = append(conditions, "updated_at >= "+args.add(filter.UpdatedFrom))
+	}
+	if !filter.UpdatedTo.IsZero() {
+		conditions = append(conditions, "updated_at < "+args.add(filter.UpdatedTo))
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, `EXISTS (
+			SELECT 1 FROM news_tags nt JOIN tags t ON t.id = nt.tag_id
+			WHERE nt.news_slug = news.slug AND t.name = ANY(`+args.add(pq.Array(filter.Tags))+`))`)
+	}
+	if filter.CreatedBy != "" {
+		conditions = append(conditions, "created_by = "+args.add(filter.CreatedBy))
+	}
+	if filter.UpdatedBy != "" {
+		conditions = append(conditions, "updated_by = "+args.add(filter.UpdatedBy))
+	}
+	if filter.TitlePrefix != "" {
+		conditions = append(conditions, `lower(title) LIKE lower(`+args.add(escapeLike(filter.TitlePrefix)+"%")+`) ESCAPE '\'`)
+	}
+
+	return conditions
+}
+
+// escapeLike экранирует спецсимволы шаблона LIKE
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (r *newsRepository) Update(ctx context.Context, slug string, patch domain.NewsPatch) (*domain.News, error) {
+	// Собираем SET только из переданных полей; имена колонок фиксированы,
+	// значения передаются параметрами
+	args := queryArgs{slug}
+	var set []string
+	if patch.Title != nil {
+		set = append(set, "title = "+args.add(*patch.Title))
+	}
+	if patch.Content != nil {
+		set = append(set, "content = "+args.add(*patch.Content))
+	}
+	set = append(set,
+		"updated_at = "+args.add(time.Now()),
+		"updated_by = "+args.add(patch.Author),
+		"version = version + 1")
+
+	where := "slug = $1 AND deleted_at IS NULL"
+	if patch.ExpectedVersion > 0 {
+		where += " AND version = " + args.add(patch.ExpectedVersion)
+	}
+
+	query := `
+		UPDATE news
+		SET ` + strings.Join(set, ", ") + `
+		WHERE ` + where + `
+		RETURNING ` + newsColumns
+
+	// Ревизия пишется в той же транзакции: изменение без ревизии невозможно
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	news, err := scanNews(r.queryRowContext(ctx, tx, "UPDATE news", query, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, r.missingOrConflict(ctx, slug)
+		}
+		return nil, fmt.Errorf("failed to update news: %w", err)
+	}
+
+	if err := r.insertRevision(ctx, tx, news); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit news update: %w", err)
+	}
+	r.replicas.pin(ctx)
+
+	return news, nil
+}
+
+// insertRevision сохраняет текущее состояние новости как ревизию ее версии;
+// автор ревизии - news.UpdatedBy
+func (r *newsRepository) insertRevision(ctx context.Context, tx *sql.Tx, news *domain.News) error {
+	query := `
+		INSERT INTO news_revisions (news_slug, version, title, content, author, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+	_, err := r.execContext(ctx, tx, "INSERT news_revisions", query, news.Slug, news.Version, news.Title, news.Content, news.UpdatedBy, news.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create revision: %w", err)
+	}
+	return nil
+}
+
+// revisionColumns - колонки, которые читает scanRevision, в том же порядке
+const revisionColumns = `news_slug, version, title, content, author, created_at`
+
+func scanRevision(row rowScanner) (*domain.Revision, error) {
+	revision := &domain.Revision{}
+	err := row.Scan(
+		&revision.Slug,
+		&revision.Version,
+		&revision.Title,
+		&revision.Content,
+		&revision.Author,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return revision, nil
+}
+
+// ListRevisions и GetRevision не показывают историю новостей из корзины
+func (r *newsRepository) ListRevisions(ctx context.Context, slug string, offset, limit int) ([]*domain.Revision, int64, error) {
+	db := r.reader(ctx)
+
+	var total int64
+	countQuery := `
+		SELECT COUNT(*) FROM news_revisions
+		WHERE news_slug = $1 AND EXISTS (SELECT 1 FROM news WHERE slug = $1 AND deleted_at IS NULL)`
+	if err := r.queryRowContext(ctx, db, "SELECT COUNT news_revisions", countQuery, slug).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to get revisions count: %w", err)
+	}
+	// У каждой новости есть хотя бы одна ревизия
+	if total == 0 {
+		return nil, 0, errors.ErrNewsNotFound
+	}
+
+	query := `
+		SELECT ` + revisionColumns + `
+		FROM news_revisions
+		WHERE news_slug = $1
+		ORDER BY version DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.queryContext(ctx, db, "SELECT news_revisions", query, slug, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []*domain.Revision
+	for rows.Next() {
+		revision, err := scanRevision(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to list revisions: %w", err)
+	}
+
+	return revisions, total, nil
+}
+
+func (r *newsRepository) GetRevision(ctx context.Context, slug string, version int64) (*domain.Revision, error) {
+	query := `
+		SELECT ` + revisionColumns + `
+		FROM news_revisions
+		WHERE news_slug = $1 AND version = $2
+			AND EXISTS (SELECT 1 FROM news WHERE slug = $1 AND deleted_at IS NULL)
+	`
+
+	revision, err := scanRevision(r.queryRowContext(ctx, r.reader(ctx), "SELECT news_revisions", query, slug, version))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errors.ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return revision, nil
+}
+
+// Delete переносит новость в корзину: строка остается в таблице
+// с заполненным deleted_at до окончательной очистки через Purge
+func (r *newsRepository) Delete(ctx context.Context, slug string, expectedVersion int64, updatedBy string) error {
+	args := queryArgs{slug}
+	query := `
+		UPDATE news
+		SET deleted_at = ` + args.add(time.Now()) + `, updated_by = ` + args.add(updatedBy) + `, version = version + 1
+		WHERE slug = $1 AND deleted_at IS NULL`
+	if expectedVersion > 0 {
+		query += ` AND version = ` + args.add(expectedVersion)
+	}
+	query += ` RETURNING ` + newsColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	news, err := scanNews(r.queryRowContext(ctx, tx, "UPDATE news", query, args...))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return r.missingOrConflict(ctx, slug)
+		}
+		return fmt.Errorf("failed to delete news: %w", err)
+	}
+
+	if err := r.insertRevision(ctx, tx, news); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit news deletion: %w", err)
+	}
+	r.replicas.pin(ctx)
+
+	return nil
+}
+
+func (r *newsRepository) Restore(ctx context.Context, slug string, updatedBy string) (*domain.News, error) {
+	query := `
+		UPDATE news
+		SET deleted_at = NULL, updated_by = $2, version = version + 1
+		WHERE slug = $1 AND deleted_at IS NOT NULL
+		RETURNING ` + newsColumns
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	news, err := scanNews(r.queryRowContext(ctx, tx, "UPDATE news", query, slug, updatedBy))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, r.missing(ctx, slug)
+		}
+		return nil, fmt.Errorf("failed to restore news: %w", err)
+	}
+
+	if err := r.insertRevision(ctx, tx, news); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit news restore: %w", err)
+	}
+	r.replicas.pin(ctx)
+
+	return news, nil
+}
+
+func (r *newsRepository) ListDeleted(ctx context.Context, offset, limit int) ([]*domain.News, int64, error) {
+	db := r.reader(ctx)
+
+	var total int64
+	countQuery := `SELECT COUNT(*) FROM news WHERE deleted_at IS NOT NULL`
+	if err := r.queryRowContext(ctx, db, "SELECT COUNT news", countQuery).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to get deleted news count: %w", err)
 	}
 
-	// Получаем записи с пагинацией
 	query := `
-		SELECT slug, title, content, created_at, updated_at
+		SELECT ` + newsColumns + `
 		FROM news
-		ORDER BY created_at DESC
+		WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, slug DESC
 		LIMIT $1 OFFSET $2
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	newsList, err := r.queryNews(ctx, db, query, limit, offset)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to get news list: %w", err)
+		return nil, 0, fmt.Errorf("failed to get deleted news list: %w", err)
+	}
+
+	return newsList, total, nil
+}
+
+func (r *newsRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `DELETE FROM news WHERE deleted_at IS NOT NULL AND deleted_at < $1`
+
+	result, err := r.execContext(ctx, r.db, "DELETE news", query, deletedBefore)
+	if err != nil {
+		return 0, fmt.Errorf("failed to purge deleted news: %w", err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if purged > 0 {
+		r.replicas.pin(ctx)
+	}
+
+	return purged, nil
+}
+
+// publicCondition оставляет новости, видимые читателям; $2 - текущее время
+const publicCondition = `deleted_at IS NULL AND status = 'published' AND publish_at <= $2`
+
+// searchQuery объединяет разбор запроса русской и английской конфигурациями.
+// ts_headline использует конфигурацию russian: в ней латинские слова
+// обрабатываются английским стеммером, поэтому подсвечиваются оба языка.
+const searchQuery = `
+	WITH q AS (
+		SELECT websearch_to_tsquery('russian', $1) || websearch_to_tsquery('english', $1) AS query
+	)`
+
+func (r *newsRepository) Search(ctx context.Context, query string, offset, limit int) ([]*domain.SearchHit, int64, error) {
+	db := r.reader(ctx)
+	now := time.Now()
+
+	var total int64
+	countQuery := searchQuery + `
+		SELECT COUNT(*) FROM news, q
+		WHERE ` + publicCondition + ` AND search_vector @@ q.query`
+	if err := r.queryRowContext(ctx, db, "SELECT COUNT news", countQuery, query, now).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to get search count: %w", err)
+	}
+
+	selectQuery := searchQuery + `
+		SELECT ` + newsColumns + `,
+			ts_rank(search_vector, q.query) AS rank,
+			ts_headline('russian', title, q.query, 'HighlightAll=true'),
+			ts_headline('russian', content, q.query, 'MaxFragments=2, MaxWords=30, MinWords=10')
+		FROM news, q
+		WHERE ` + publicCondition + ` AND search_vector @@ q.query
+		ORDER BY rank DESC, created_at DESC, slug DESC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.queryContext(ctx, db, "SELECT news", selectQuery, query, now, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search news: %w", err)
 	}
 	defer rows.Close()
 
-	var newsList []*domain.News
+	var hits []*domain.SearchHit
 	for rows.Next() {
-		news := &domain.News{}
-		err := rows.Scan(
-			&news.Slug,
-			&news.Title,
-			&news.Content,
-			&news.CreatedAt,
-			&news.UpdatedAt,
-		)
+		hit := &domain.SearchHit{}
+		hit.News, err = scanNews(rows, &hit.Rank, &hit.TitleHighlight, &hit.Snippet)
 		if err != nil {
-			return nil, 0, fmt.Errorf("failed to scan news: %w", err)
+			return nil, 0, fmt.Errorf("failed to scan search hit: %w", err)
 		}
-		newsList = append(newsList, news)
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to search news: %w", err)
 	}
 
-	return newsList, total, nil
+	return hits, total, nil
 }
 
-func (r *newsRepository) Update(ctx context.Context, slug string, news *domain.News) error {
+func (r *newsRepository) Rename(ctx context.Context, slug, newSlug string, expectedVersion int64, updatedBy string) (*domain.News, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Блокируем строку, чтобы проверка версии и переименование были атомарны
+	var version int64
+	err = r.queryRowContext(ctx, tx, "SELECT news FOR UPDATE", `SELECT version FROM news WHERE slug = $1 AND deleted_at IS NULL FOR UPDATE`, slug).Scan(&version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, r.missing(ctx, slug)
+		}
+		return nil, fmt.Errorf("failed to lock news: %w", err)
+	}
+	if expectedVersion > 0 && version != expectedVersion {
+		return nil, errors.ErrConflict
+	}
+
+	// Новость может вернуть себе один из своих прежних slug, но не чужой
+	var aliasOwner string
+	err = r.queryRowContext(ctx, tx, "SELECT news_slug_aliases", `SELECT slug FROM news_slug_aliases WHERE old_slug = $1`, newSlug).Scan(&aliasOwner)
+	switch {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return nil, fmt.Errorf("failed to check slug alias: %w", err)
+	case aliasOwner != slug:
+		return nil, errors.ErrDuplicateSlug
+	default:
+		if _, err := r.execContext(ctx, tx, "DELETE news_slug_aliases", `DELETE FROM news_slug_aliases WHERE old_slug = $1`, newSlug); err != nil {
+			return nil, fmt.Errorf("failed to delete slug alias: %w", err)
+		}
+	}
+
+	// Алиасы и теги, указывающие на старый slug, переносятся каскадно (ON UPDATE CASCADE)
+	query := `UPDATE news SET slug = $2, updated_at = $3, updated_by = $4, version = version + 1 WHERE slug = $1`
+	if _, err := r.execContext(ctx, tx, "UPDATE news", query, slug, newSlug, time.Now(), updatedBy); err != nil {
+		if isUniqueViolation(err) {
+			return nil, errors.ErrDuplicateSlug
+		}
+		return nil, fmt.Errorf("failed to rename news: %w", err)
+	}
+
+	if _, err := r.execContext(ctx, tx, "INSERT news_slug_aliases", `INSERT INTO news_slug_aliases (old_slug, slug) VALUES ($1, $2)`, slug, newSlug); err != nil {
+		return nil, fmt.Errorf("failed to create slug alias: %w", err)
+	}
+
+	// Читаем новость после каскадного обновления, чтобы получить ее теги
+	news, err := scanNews(r.queryRowContext(ctx, tx, "SELECT news", `SELECT `+newsColumns+` FROM news WHERE slug = $1`, newSlug))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get renamed news: %w", err)
+	}
+
+	if err := r.insertRevision(ctx, tx, news); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit rename: %w", err)
+	}
+	r.replicas.pin(ctx)
+
+	return news, nil
+}
+
+func (r *newsRepository) SetTags(ctx context.Context, slug string, tags []string) (*domain.News, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Блокируем новость, чтобы параллельные изменения тегов не смешались
+	var locked string
+	err = r.queryRowContext(ctx, tx, "SELECT news FOR UPDATE", `SELECT slug FROM news WHERE slug = $1 AND deleted_at IS NULL FOR UPDATE`, slug).Scan(&locked)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, r.missing(ctx, slug)
+		}
+		return nil, fmt.Errorf("failed to lock news: %w", err)
+	}
+
+	if _, err := r.execContext(ctx, tx, "DELETE news_tags", `DELETE FROM news_tags WHERE news_slug = $1`, slug); err != nil {
+		return nil, fmt.Errorf("failed to clear news tags: %w", err)
+	}
+
+	if len(tags) > 0 {
+		query := `INSERT INTO tags (name) SELECT unnest($1::VARCHAR[]) ON CONFLICT (name) DO NOTHING`
+		if _, err := r.execContext(ctx, tx, "INSERT tags", query, pq.Array(tags)); err != nil {
+			return nil, fmt.Errorf("failed to create tags: %w", err)
+		}
+
+		query = `INSERT INTO news_tags (news_slug, tag_id) SELECT $1, id FROM tags WHERE name = ANY($2)`
+		if _, err := r.execContext(ctx, tx, "INSERT news_tags", query, slug, pq.Array(tags)); err != nil {
+			return nil, fmt.Errorf("failed to assign tags: %w", err)
+		}
+	}
+
+	news, err := scanNews(r.queryRowContext(ctx, tx, "SELECT news", `SELECT `+newsColumns+` FROM news WHERE slug = $1`, slug))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get news: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit tags: %w", err)
+	}
+	r.replicas.pin(ctx)
+
+	return news, nil
+}
+
+func (r *newsRepository) SetStatus(ctx context.Context, slug string, status domain.NewsStatus, publishAt *time.Time, expectedVersion int64, updatedBy string) (*domain.News, error) {
+	args := queryArgs{slug}
 	query := `
 		UPDATE news
-		SET title = $2, content = $3, updated_at = $4
-		WHERE slug = $1
-	`
+		SET status = ` + args.add(status) + `,
+			publish_at = COALESCE(` + args.add(publishAt) + `::TIMESTAMP, publish_at),
+			updated_at = ` + args.add(time.Now()) + `,
+			updated_by = ` + args.add(updatedBy) + `,
+			version = version + 1
+		WHERE slug = $1 AND deleted_at IS NULL`
+	if expectedVersion > 0 {
+		query += ` AND version = ` + args.add(expectedVersion)
+	}
+	query += ` RETURNING ` + newsColumns
 
-	news.UpdatedAt = time.Now()
-	result, err := r.db.ExecContext(ctx, query, slug, news.Title, news.Content, news.UpdatedAt)
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return fmt.Errorf("failed to update news: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
 	}
+	defer tx.Rollback()
 
-	rowsAffected, err := result.RowsAffected()
+	news, err := scanNews(r.queryRowContext(ctx, tx, "UPDATE news", query, args...))
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		if err == sql.ErrNoRows {
+			return nil, r.missingOrConflict(ctx, slug)
+		}
+		return nil, fmt.Errorf("failed to set news status: %w", err)
 	}
 
-	if rowsAffected == 0 {
-		return errors.ErrNewsNotFound
+	if err := r.insertRevision(ctx, tx, news); err != nil {
+		return nil, err
 	}
 
-	news.Slug = slug
-	return nil
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit news status: %w", err)
+	}
+	r.replicas.pin(ctx)
+
+	return news, nil
 }
 
-func (r *newsRepository) Delete(ctx context.Context, slug string) error {
-	query := `DELETE FROM news WHERE slug = $1`
+func (r *newsRepository) PublishDue(ctx context.Context, now time.Time) ([]string, error) {
+	// Ревизии опубликованных новостей пишутся тем же запросом, то есть атомарно
+	query := `
+		WITH published AS (
+			UPDATE news
+			SET status = 'published', version = version + 1
+			WHERE status = 'scheduled' AND publish_at <= $1 AND deleted_at IS NULL
+			RETURNING slug, version, title, content, updated_by, updated_at
+		), revisions AS (
+			INSERT INTO news_revisions (news_slug, version, title, content, author, created_at)
+			SELECT slug, version, title, content, updated_by, updated_at FROM published
+		)
+		SELECT slug FROM published
+	`
 
-	result, err := r.db.ExecContext(ctx, query, slug)
+	// Планировщик вызывает метод часто и обычно ничего не меняет, поэтому
+	// запись отмечается в наборе реплик, только если что-то опубликовано
+	rows, err := r.queryContext(ctx, r.db, "UPDATE news", query, now)
 	if err != nil {
-		return fmt.Errorf("failed to delete news: %w", err)
+		return nil, fmt.Errorf("failed to publish scheduled news: %w", err)
 	}
+	defer rows.Close()
 
-	rowsAffected, err := result.RowsAffected()
+	var slugs []string
+	for rows.Next() {
+		var slug string
+		if err := rows.Scan(&slug); err != nil {
+			return nil, fmt.Errorf("failed to scan published slug: %w", err)
+		}
+		slugs = append(slugs, slug)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to publish scheduled news: %w", err)
+	}
+	if len(slugs) > 0 {
+		r.replicas.pin(ctx)
+	}
+
+	return slugs, nil
+}
+
+func (r *newsRepository) ListTags(ctx context.Context) ([]domain.TagCount, error) {
+	query := `
+		SELECT t.name, COUNT(*) AS news_count
+		FROM tags t
+		JOIN news_tags nt ON nt.tag_id = t.id
+		JOIN news n ON n.slug = nt.news_slug AND n.deleted_at IS NULL
+			AND n.status = 'published' AND n.publish_at <= $1
+		GROUP BY t.name
+		ORDER BY news_count DESC, t.name
+	`
+
+	rows, err := r.queryContext(ctx, r.reader(ctx), "SELECT tags", query, time.Now())
 	if err != nil {
-		return fmt.Errorf("failed to get rows affected: %w", err)
+		return nil, fmt.Errorf("failed to list tags: %w", err)
 	}
+	defer rows.Close()
 
-	if rowsAffected == 0 {
+	var tags []domain.TagCount
+	for rows.Next() {
+		var tag domain.TagCount
+		if err := rows.Scan(&tag.Name, &tag.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// missingOrConflict объясняет, почему запись с условием по версии не изменилась:
+// новости нет вовсе или ее версия уже другая. Читает из primary: реплика
+// могла еще не получить последнюю версию.
+func (r *newsRepository) missingOrConflict(ctx context.Context, slug string) error {
+	var exists bool
+	query := `SELECT EXISTS(SELECT 1 FROM news WHERE slug = $1 AND deleted_at IS NULL)`
+	if err := r.queryRowContext(ctx, r.db, "SELECT news", query, slug).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check news existence: %w", err)
+	}
+
+	if exists {
+		return errors.ErrConflict
+	}
+	return r.missing(ctx, slug)
+}
+
+// missing объясняет, почему изменяющий метод не нашел новость: если slug -
+// старый slug переименованной новости, возвращается SlugMovedError с
+// актуальным slug, иначе ErrNewsNotFound. Читает из primary.
+func (r *newsRepository) missing(ctx context.Context, slug string) error {
+	var canonical string
+	query := `SELECT slug FROM news_slug_aliases WHERE old_slug = $1`
+	err := r.queryRowContext(ctx, r.db, "SELECT news_slug_aliases", query, slug).Scan(&canonical)
+	switch {
+	case err == sql.ErrNoRows:
 		return errors.ErrNewsNotFound
+	case err != nil:
+		return fmt.Errorf("failed to check slug alias: %w", err)
 	}
+	return &errors.SlugMovedError{Slug: canonical}
+}
 
-	return nil
+// isUniqueViolation проверяет нарушение уникальности (код 23505)
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
+
+// queryNews выполняет запрос, возвращающий колонки newsColumns
+func (r *newsRepository) queryNews(ctx context.Context, q querier, query string, args ...interface{}) ([]*domain.News, error) {
+	rows, err := r.queryContext(ctx, q, "SELECT news", query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var newsList []*domain.News
+	for rows.Next() {
+		news, err := scanNews(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan news: %w", err)
+		}
+		newsList = append(newsList, news)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return newsList, nil
+}
+
+// queryArgs накапливает параметры запроса и возвращает их плейсхолдеры
+type queryArgs []interface{}
+
+func (a *queryArgs) add(value interface{}) string {
+	*a = append(*a, value)
+	return fmt.Sprintf("$%d", len(*a))
+}
+
+// newsColumns - колонки, которые читает scanNews, в том же порядке.
+// Теги собираются подзапросом, поэтому в FROM таблица news не должна иметь псевдоним.
+const newsColumns = `slug, title, content, created_at, updated_at, version, deleted_at, status, publish_at,
+	created_by, updated_by,
+	ARRAY(
+		SELECT t.name FROM news_tags nt JOIN tags t ON t.id = nt.tag_id
+		WHERE nt.news_slug = news.slug ORDER BY t.name
+	) AS tags`
+
+// rowScanner - общий интерфейс *sql.Row и *sql.Rows
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanNews читает колонки newsColumns; extra - приемники для колонок,
+// выбранных после них
+func scanNews(row rowScanner, extra ...interface{}) (*domain.News, error) {
+	news := &domain.News{}
+	var deletedAt, publishAt sql.NullTime
+	dest := append([]interface{}{
+		&news.Slug,
+		&news.Title,
+		&news.Content,
+		&news.CreatedAt,
+		&news.UpdatedAt,
+		&news.Version,
+		&deletedAt,
+		&news.Status,
+		&publishAt,
+		&news.CreatedBy,
+		&news.UpdatedBy,
+		(*pq.StringArray)(&news.Tags),
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if publishAt.Valid {
+		news.PublishAt = &publishAt.Time
+	}
+	if deletedAt.Valid {
+		news.DeletedAt = &deletedAt.Time
+	}
+	return news, nil
 }
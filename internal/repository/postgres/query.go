@@ -0,0 +1,78 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("news-service/internal/repository/postgres")
+
+// querier - общие методы *sql.DB и *sql.Tx
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// Каждый SQL-запрос выполняется в отдельном span и записывается в лог на
+// уровне debug: name коротко описывает запрос ("SELECT COUNT news"), полный
+// текст попадает в атрибут db.statement.
+
+type querySpan struct {
+	trace.Span
+	name  string
+	start time.Time
+}
+
+func startQuerySpan(ctx context.Context, name, query string) (context.Context, querySpan) {
+	ctx, span := tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("db.system", "postgresql"),
+			attribute.String("db.statement", query),
+		),
+	)
+	return ctx, querySpan{Span: span, name: name, start: time.Now()}
+}
+
+func (r *newsRepository) endQuery(ctx context.Context, span querySpan, err error) {
+	attrs := []any{"query", span.name, "duration", time.Since(span.start)}
+	if err != nil && !stderrors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		attrs = append(attrs, "error", err)
+	}
+	span.End()
+	r.logger.Log(ctx, slog.LevelDebug, "SQL query", attrs...)
+}
+
+func (r *newsRepository) execContext(ctx context.Context, q querier, name, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startQuerySpan(ctx, name, query)
+	result, err := q.ExecContext(ctx, query, args...)
+	r.endQuery(ctx, span, err)
+	return result, err
+}
+
+// queryContext завершает span после получения первых строк ответа;
+// чтение остальных строк в него не входит
+func (r *newsRepository) queryContext(ctx context.Context, q querier, name, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startQuerySpan(ctx, name, query)
+	rows, err := q.QueryContext(ctx, query, args...)
+	r.endQuery(ctx, span, err)
+	return rows, err
+}
+
+func (r *newsRepository) queryRowContext(ctx context.Context, q querier, name, query string, args ...interface{}) *sql.Row {
+	ctx, span := startQuerySpan(ctx, name, query)
+	row := q.QueryRowContext(ctx, query, args...)
+	r.endQuery(ctx, span, row.Err())
+	return row
+}
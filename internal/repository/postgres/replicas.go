@@ -0,0 +1,196 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"news-service/internal/repository"
+)
+
+// ReplicaConfig - настройки маршрутизации чтения по репликам
+type ReplicaConfig struct {
+	// CheckInterval - период проверки реплик; недоступная реплика исключается
+	// из ротации до следующей успешной проверки
+	CheckInterval time.Duration
+	// CheckTimeout ограничивает одну проверку
+	CheckTimeout time.Duration
+	// ReadYourWrites - сколько после записи чтения вызывающего идут в primary,
+	// чтобы он видел свои изменения несмотря на задержку репликации; 0 отключает.
+	// Это же окно считается предельной задержкой реплик: чтения из реплики в
+	// течение него после любой записи отмечаются как возможно устаревшие.
+	ReadYourWrites time.Duration
+	// CallerKey определяет вызывающего (пользователь или адрес клиента);
+	// пустая строка означает, что закреплять некого
+	CallerKey func(ctx context.Context) string
+}
+
+type replica struct {
+	name    string
+	db      *sql.DB
+	healthy atomic.Bool
+}
+
+// ReplicaSet распределяет запросы чтения по репликам по кругу, пропуская
+// недоступные. Если доступных реплик нет, чтение идет в primary.
+type ReplicaSet struct {
+	cfg      ReplicaConfig
+	logger   *slog.Logger
+	replicas []*replica
+	next     atomic.Uint64
+	// lastWrite - время последней записи в UnixNano
+	lastWrite atomic.Int64
+
+	mu   sync.Mutex
+	pins map[string]time.Time
+}
+
+// NewReplicaSet создает набор реплик. До первой проверки реплики считаются
+// доступными; Run нужно запустить, чтобы недоступные исключались.
+func NewReplicaSet(dbs []*sql.DB, cfg ReplicaConfig, logger *slog.Logger) *ReplicaSet {
+	rs := &ReplicaSet{
+		cfg:    cfg,
+		logger: logger,
+		pins:   make(map[string]time.Time),
+	}
+	for i, db := range dbs {
+		rep := &replica{name: fmt.Sprintf("replica-%d", i), db: db}
+		rep.healthy.Store(true)
+		rs.replicas = append(rs.replicas, rep)
+	}
+	return rs
+}
+
+// Run проверяет реплики каждые CheckInterval до отмены ctx
+func (rs *ReplicaSet) Run(ctx context.Context) {
+	ticker := time.NewTicker(rs.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		rs.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (rs *ReplicaSet) check(ctx context.Context) {
+	for _, rep := range rs.replicas {
+		checkCtx, cancel := context.WithTimeout(ctx, rs.cfg.CheckTimeout)
+		err := rep.db.PingContext(checkCtx)
+		cancel()
+
+		healthy := err == nil
+		if rep.healthy.Swap(healthy) == healthy {
+			continue
+		}
+		if healthy {
+			rs.logger.InfoContext(ctx, "Replica is back in rotation", "replica", rep.name)
+		} else {
+			rs.logger.WarnContext(ctx, "Replica ejected from rotation", "replica", rep.name, "error", err)
+		}
+	}
+
+	rs.sweepPins(time.Now())
+}
+
+// reader выбирает следующую доступную реплику; nil - читать из primary.
+// Чтение из реплики вскоре после записи отмечается через
+// repository.MarkMayBeStale, чтобы его результат не попал в общий кеш.
+func (rs *ReplicaSet) reader(ctx context.Context) *sql.DB {
+	if rs == nil || len(rs.replicas) == 0 || rs.pinned(ctx) {
+		return nil
+	}
+
+	start := rs.next.Add(1)
+	for i := range rs.replicas {
+		rep := rs.replicas[(start+uint64(i))%uint64(len(rs.replicas))]
+		if rep.healthy.Load() {
+			if rs.mayLag(time.Now()) {
+				repository.MarkMayBeStale(ctx)
+			}
+			return rep.db
+		}
+	}
+	return nil
+}
+
+// mayLag сообщает, могут ли реплики еще не получить последнюю запись.
+// Без окна ReadYourWrites задержка неизвестна, и реплика может отставать всегда.
+func (rs *ReplicaSet) mayLag(now time.Time) bool {
+	if rs.cfg.ReadYourWrites <= 0 {
+		return true
+	}
+	return now.Sub(time.Unix(0, rs.lastWrite.Load())) < rs.cfg.ReadYourWrites
+}
+
+// pin запоминает время записи и закрепляет вызывающего за primary на время
+// ReadYourWrites. Вызывается после фиксации записи: иначе запись, ждавшая
+// блокировку дольше окна, оказалась бы в репликах уже после его окончания.
+func (rs *ReplicaSet) pin(ctx context.Context) {
+	if rs == nil {
+		return
+	}
+	rs.lastWrite.Store(time.Now().UnixNano())
+	if rs.cfg.ReadYourWrites <= 0 || rs.cfg.CallerKey == nil {
+		return
+	}
+	key := rs.cfg.CallerKey(ctx)
+	if key == "" {
+		return
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.pins[key] = time.Now().Add(rs.cfg.ReadYourWrites)
+}
+
+func (rs *ReplicaSet) pinned(ctx context.Context) bool {
+	if rs.cfg.ReadYourWrites <= 0 || rs.cfg.CallerKey == nil {
+		return false
+	}
+	key := rs.cfg.CallerKey(ctx)
+	if key == "" {
+		return false
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	until, ok := rs.pins[key]
+	return ok && time.Now().Before(until)
+}
+
+// sweepPins удаляет истекшие закрепления, чтобы карта не росла
+func (rs *ReplicaSet) sweepPins(now time.Time) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for key, until := range rs.pins {
+		if !now.Before(until) {
+			delete(rs.pins, key)
+		}
+	}
+}
+
+// WithReplicas направляет запросы чтения вне транзакций в реплики
+func WithReplicas(replicas *ReplicaSet) Option {
+	return func(r *newsRepository) {
+		r.replicas = replicas
+	}
+}
+
+// reader возвращает базу для запроса чтения: реплику или primary. Запросы
+// одного метода репозитория выполняются на одной базе, чтобы, например,
+// COUNT и выборка страницы не разошлись из-за разной задержки реплик.
+func (r *newsRepository) reader(ctx context.Context) querier {
+	if db := r.replicas.reader(ctx); db != nil {
+		return db
+	}
+	return r.db
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"news-service/internal/domain"
+	"news-service/internal/repository"
+)
+
+type callerKey struct{}
+
+func withCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+func testCallerKey(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+// openUnreachable открывает пул к адресу, где никто не слушает: sql.Open не
+// подключается, а Ping сразу получает отказ в соединении
+func openUnreachable(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("postgres", "host=127.0.0.1 port=1 sslmode=disable connect_timeout=1")
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func newTestRepository(t *testing.T, replicas int, cfg ReplicaConfig) (*newsRepository, []*sql.DB) {
+	t.Helper()
+	dbs := make([]*sql.DB, replicas)
+	for i := range dbs {
+		dbs[i] = openUnreachable(t)
+	}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	set := NewReplicaSet(dbs, cfg, logger)
+	r := NewNewsRepository(openUnreachable(t), logger, WithReplicas(set)).(*newsRepository)
+	return r, dbs
+}
+
+func TestReplicaSet_RoundRobin(t *testing.T) {
+	r, dbs := newTestRepository(t, 2, ReplicaConfig{})
+
+	first, second, third := r.reader(context.Background()), r.reader(context.Background()), r.reader(context.Background())
+
+	if first == second {
+		t.Error("Expected consecutive reads to use different replicas")
+	}
+	if first != third {
+		t.Error("Expected reads to cycle through replicas")
+	}
+	for _, q := range []querier{first, second} {
+		if q != dbs[0] && q != dbs[1] {
+			t.Error("Expected reads to go to replicas, not to primary")
+		}
+	}
+}
+
+func TestReplicaSet_EjectsUnreachableReplicas(t *testing.T) {
+	r, _ := newTestRepository(t, 2, ReplicaConfig{CheckTimeout: time.Second})
+
+	r.replicas.check(context.Background())
+
+	for _, rep := range r.replicas.replicas {
+		if rep.healthy.Load() {
+			t.Errorf("Expected %s to be ejected", rep.name)
+		}
+	}
+	if q := r.reader(context.Background()); q != r.db {
+		t.Error("Expected reads to fall back to primary without healthy replicas")
+	}
+
+	// Вернувшаяся реплика снова получает запросы
+	r.replicas.replicas[1].healthy.Store(true)
+	if q := r.reader(context.Background()); q != r.replicas.replicas[1].db {
+		t.Error("Expected reads to go to the healthy replica")
+	}
+}
+
+func TestReplicaSet_ReadYourWrites(t *testing.T) {
+	r, _ := newTestRepository(t, 1, ReplicaConfig{ReadYourWrites: time.Minute, CallerKey: testCallerKey})
+	alice := withCaller(context.Background(), "user:alice")
+	bob := withCaller(context.Background(), "user:bob")
+
+	r.replicas.pin(alice)
+
+	if q := r.reader(alice); q != r.db {
+		t.Error("Expected writer's reads to be pinned to primary")
+	}
+	if q := r.reader(bob); q == r.db {
+		t.Error("Expected other callers to keep reading from replicas")
+	}
+
+	// После окна закрепления чтения снова идут в реплики
+	r.replicas.pins["user:alice"] = time.Now().Add(-time.Second)
+	if q := r.reader(alice); q == r.db {
+		t.Error("Expected reads to return to replicas after the window")
+	}
+	r.replicas.sweepPins(time.Now())
+	if len(r.replicas.pins) != 0 {
+		t.Errorf("Expected expired pins to be removed, got %v", r.replicas.pins)
+	}
+}
+
+func TestNewsRepository_WithoutReplicasUsesPrimary(t *testing.T) {
+	r := NewNewsRepository(openUnreachable(t), slog.New(slog.NewTextHandler(io.Discard, nil))).(*newsRepository)
+
+	if q := r.reader(context.Background()); q != r.db {
+		t.Error("Expected reads to go to primary without replicas")
+	}
+	// Без реплик отмечать записи негде
+	r.replicas.pin(context.Background())
+}
+
+func TestNewsRepository_FailedWriteDoesNotPin(t *testing.T) {
+	r, _ := newTestRepository(t, 1, ReplicaConfig{ReadYourWrites: time.Minute, CallerKey: testCallerKey})
+	alice := withCaller(context.Background(), "user:alice")
+
+	// Primary недоступен: запись не фиксируется, и закреплять нечего
+	if err := r.Create(alice, &domain.News{Slug: "news", Title: "Title", Content: "Content"}); err == nil {
+		t.Fatal("Expected write to unreachable primary to fail")
+	}
+
+	if len(r.replicas.pins) != 0 || r.replicas.lastWrite.Load() != 0 {
+		t.Error("Expected failed write to leave no pin and no write time")
+	}
+	ctx, reads := repository.TrackReads(alice)
+	if q := r.reader(ctx); q == r.db || reads.MayBeStale() {
+		t.Error("Expected reads after a failed write to use a fresh replica")
+	}
+}
+
+func TestReplicaSet_MarksReadsInsideLagWindow(t *testing.T) {
+	r, _ := newTestRepository(t, 1, ReplicaConfig{ReadYourWrites: time.Minute})
+
+	// До первой записи реплике нечего догонять
+	ctx, reads := repository.TrackReads(context.Background())
+	r.reader(ctx)
+	if reads.MayBeStale() {
+		t.Error("Expected reads before any write to be fresh")
+	}
+
+	r.replicas.pin(context.Background())
+	ctx, reads = repository.TrackReads(context.Background())
+	if q := r.reader(ctx); q == r.db {
+		t.Fatal("Expected read to go to replica")
+	}
+	if !reads.MayBeStale() {
+		t.Error("Expected replica read right after a write to be marked")
+	}
+
+	// После окна задержки реплика считается догнавшей primary
+	r.replicas.lastWrite.Store(time.Now().Add(-2 * time.Minute).UnixNano())
+	ctx, reads = repository.TrackReads(context.Background())
+	r.reader(ctx)
+	if reads.MayBeStale() {
+		t.Error("Expected replica read after the window to be fresh")
+	}
+}
+
+func TestReplicaSet_MarksAllReadsWithoutLagWindow(t *testing.T) {
+	r, _ := newTestRepository(t, 1, ReplicaConfig{})
+
+	ctx, reads := repository.TrackReads(context.Background())
+	r.reader(ctx)
+	if !reads.MayBeStale() {
+		t.Error("Expected replica reads to be marked without ReadYourWrites")
+	}
+
+	// Чтение из primary всегда актуально
+	r.replicas.replicas[0].healthy.Store(false)
+	ctx, reads = repository.TrackReads(context.Background())
+	if q := r.reader(ctx); q != r.db || reads.MayBeStale() {
+		t.Error("Expected primary read to be fresh")
+	}
+}
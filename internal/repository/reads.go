@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// ReadTracker узнает, могли ли чтения в контексте вернуть устаревшие данные.
+// Реплика отстает от primary, поэтому сразу после записи прочитанное из нее
+// нельзя класть в общий кеш: устаревшая запись продержалась бы там весь TTL.
+type ReadTracker struct {
+	mayBeStale atomic.Bool
+}
+
+type readTrackerKey struct{}
+
+// TrackReads возвращает контекст, чтения в котором отмечаются в трекере
+func TrackReads(ctx context.Context) (context.Context, *ReadTracker) {
+	tracker := &ReadTracker{}
+	return context.WithValue(ctx, readTrackerKey{}, tracker), tracker
+}
+
+// MayBeStale сообщает, что хотя бы одно чтение могло вернуть устаревшие данные
+func (t *ReadTracker) MayBeStale() bool {
+	return t.mayBeStale.Load()
+}
+
+// MarkMayBeStale вызывается реализацией репозитория, если чтение ушло в
+// реплику, которая может еще не получить последние записи
+func MarkMayBeStale(ctx context.Context) {
+	if tracker, ok := ctx.Value(readTrackerKey{}).(*ReadTracker); ok {
+		tracker.mayBeStale.Store(true)
+	}
+}
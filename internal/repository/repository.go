@@ -2,13 +2,108 @@ package repository
 
 import (
 	"context"
+	"time"
+
 	"news-service/internal/domain"
 )
 
+// NewsRepository хранит новости. Изменяющие методы принимают только актуальный
+// slug: по старому slug переименованной новости они возвращают
+// *errors.SlugMovedError с актуальным slug. Каждое изменение, увеличивающее
+// версию новости, в той же транзакции записывает ревизию этой версии.
 type NewsRepository interface {
+	// Create сохраняет новость и ее первую ревизию от имени news.CreatedBy
 	Create(ctx context.Context, news *domain.News) error
+	// GetBySlug ищет новость по актуальному slug, а затем по старым slug
+	// переименованных новостей; в ответе всегда актуальный slug
 	GetBySlug(ctx context.Context, slug string) (*domain.News, error)
-	GetList(ctx context.Context, offset, limit int) ([]*domain.News, int64, error)
-	Update(ctx context.Context, slug string, news *domain.News) error
-	Delete(ctx context.Context, slug string) error
+	GetList(ctx context.Context, opts ListOptions) ([]*domain.News, int64, error)
+	// Update изменяет только заданные в патче поля, записывает ревизию
+	// и возвращает новость целиком.
+	// При несовпадении patch.ExpectedVersion возвращается errors.ErrConflict.
+	Update(ctx context.Context, slug string, patch domain.NewsPatch) (*domain.News, error)
+	// Delete переносит новость в корзину; expectedVersion > 0 включает проверку версии.
+	// Все методы чтения и изменения, кроме методов корзины, не видят удаленные новости.
+	Delete(ctx context.Context, slug string, expectedVersion int64, updatedBy string) error
+	// Restore возвращает новость из корзины
+	Restore(ctx context.Context, slug string, updatedBy string) (*domain.News, error)
+	// ListDeleted возвращает новости из корзины, последние удаленные первыми
+	ListDeleted(ctx context.Context, offset, limit int) ([]*domain.News, int64, error)
+	// Purge окончательно удаляет новости, попавшие в корзину раньше deletedBefore
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	// Search ищет опубликованные новости по заголовку и содержимому, самые релевантные первыми
+	Search(ctx context.Context, query string, offset, limit int) ([]*domain.SearchHit, int64, error)
+	// Rename меняет slug новости и сохраняет старый slug как алиас
+	Rename(ctx context.Context, slug, newSlug string, expectedVersion int64, updatedBy string) (*domain.News, error)
+	// SetTags заменяет набор тегов новости, создавая новые теги при необходимости
+	SetTags(ctx context.Context, slug string, tags []string) (*domain.News, error)
+	// SetStatus меняет статус публикации; publishAt == nil оставляет время публикации прежним
+	SetStatus(ctx context.Context, slug string, status domain.NewsStatus, publishAt *time.Time, expectedVersion int64, updatedBy string) (*domain.News, error)
+	// PublishDue публикует отложенные новости, время которых наступило к now,
+	// и возвращает их slug
+	PublishDue(ctx context.Context, now time.Time) ([]string, error)
+	// ListRevisions возвращает ревизии новости, последние первыми
+	ListRevisions(ctx context.Context, slug string, offset, limit int) ([]*domain.Revision, int64, error)
+	// GetRevision возвращает ревизию новости с указанной версией
+	GetRevision(ctx context.Context, slug string, version int64) (*domain.Revision, error)
+	// ListTags возвращает теги опубликованных новостей с количеством, популярные первыми
+	ListTags(ctx context.Context) ([]domain.TagCount, error)
+}
+
+// ListOptions задает параметры выборки списка новостей
+type ListOptions struct {
+	Limit int
+	// Offset используется в постраничном режиме и игнорируется, если задан After
+	Offset int
+	// After включает keyset-пагинацию: возвращаются записи строго после курсора
+	After *Cursor
+	// WithTotal включает подсчет общего количества записей с учетом фильтра
+	WithTotal bool
+	Filter    ListFilter
+	Sort      ListSort
+}
+
+// ListFilter ограничивает выборку; нулевые значения полей не фильтруют
+type ListFilter struct {
+	// Интервалы дат полуоткрытые: [From, To)
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+	// TitlePrefix - начало заголовка без учета регистра
+	TitlePrefix string
+	// Tags оставляет новости, у которых есть хотя бы один из тегов
+	Tags []string
+	// CreatedBy и UpdatedBy оставляют новости указанных пользователей
+	CreatedBy string
+	UpdatedBy string
+	// IncludeUnpublished добавляет черновики, отложенные и архивные новости;
+	// без него в список попадают только опубликованные
+	IncludeUnpublished bool
+}
+
+// SortField - поле сортировки списка
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByUpdatedAt SortField = "updated_at"
+	SortByTitle     SortField = "title"
+)
+
+// ListSort задает порядок списка; при равных значениях поля записи
+// упорядочиваются по slug в том же направлении. Нулевое значение -
+// сортировка по created_at по убыванию.
+type ListSort struct {
+	Field     SortField
+	Ascending bool
+}
+
+// Cursor - позиция в списке: значение поля сортировки и slug последней записи
+type Cursor struct {
+	// Time используется при сортировке по created_at и updated_at
+	Time time.Time
+	// Title используется при сортировке по title
+	Title string
+	Slug  string
 }
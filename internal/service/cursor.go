@@ -0,0 +1,66 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"news-service/internal/domain"
+	"news-service/internal/repository"
+)
+
+// pageToken - содержимое непрозрачного токена страницы.
+// Время хранится в микросекундах: такую точность дает колонка TIMESTAMP.
+type pageToken struct {
+	// Sort фиксирует порядок, для которого выдан токен
+	Sort  string `json:"o"`
+	Time  int64  `json:"t,omitempty"`
+	Title string `json:"v,omitempty"`
+	Slug  string `json:"s"`
+}
+
+func sortKey(sort repository.ListSort) string {
+	field := sort.Field
+	if field == "" {
+		field = repository.SortByCreatedAt
+	}
+	if sort.Ascending {
+		return string(field) + ":asc"
+	}
+	return string(field) + ":desc"
+}
+
+func encodePageToken(last *domain.News, sort repository.ListSort) string {
+	t := pageToken{Sort: sortKey(sort), Slug: last.Slug}
+	switch sort.Field {
+	case repository.SortByTitle:
+		t.Title = last.Title
+	case repository.SortByUpdatedAt:
+		t.Time = last.UpdatedAt.UnixMicro()
+	default:
+		t.Time = last.CreatedAt.UnixMicro()
+	}
+
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodePageToken разбирает токен; токен, выданный для другого порядка
+// сортировки, считается недействительным
+func decodePageToken(token string, sort repository.ListSort) (*repository.Cursor, bool) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, false
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil || t.Slug == "" || t.Sort != sortKey(sort) {
+		return nil, false
+	}
+
+	return &repository.Cursor{
+		Time:  time.UnixMicro(t.Time).UTC(),
+		Title: t.Title,
+		Slug:  t.Slug,
+	}, true
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	stderrors "errors"
+	"strings"
+	"testing"
+	"time"
+
+	"news-service/internal/domain"
+	"news-service/internal/repository"
+	"news-service/pkg/errors"
+)
+
+func TestPageToken_RoundTrip(t *testing.T) {
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 123456789, time.UTC)
+	updatedAt := createdAt.Add(time.Hour)
+	last := &domain.News{Slug: "last", Title: "Last title", CreatedAt: createdAt, UpdatedAt: updatedAt}
+
+	tests := []struct {
+		sort repository.ListSort
+		want repository.Cursor
+	}{
+		{repository.ListSort{}, repository.Cursor{Time: createdAt.Truncate(time.Microsecond), Slug: "last"}},
+		{repository.ListSort{Field: repository.SortByCreatedAt, Ascending: true}, repository.Cursor{Time: createdAt.Truncate(time.Microsecond), Slug: "last"}},
+		{repository.ListSort{Field: repository.SortByUpdatedAt}, repository.Cursor{Time: updatedAt.Truncate(time.Microsecond), Slug: "last"}},
+		{repository.ListSort{Field: repository.SortByTitle, Ascending: true}, repository.Cursor{Title: "Last title", Slug: "last"}},
+	}
+
+	for _, tt := range tests {
+		token := encodePageToken(last, tt.sort)
+
+		cursor, ok := decodePageToken(token, tt.sort)
+		if !ok {
+			t.Errorf("%s: expected token to decode", sortKey(tt.sort))
+			continue
+		}
+		// Для сортировки по заголовку время в курсоре не используется
+		timeMatches := tt.want.Time.IsZero() || cursor.Time.Equal(tt.want.Time)
+		if cursor.Slug != tt.want.Slug || cursor.Title != tt.want.Title || !timeMatches {
+			t.Errorf("%s: expected cursor %+v, got %+v", sortKey(tt.sort), tt.want, *cursor)
+		}
+	}
+}
+
+func TestPageToken_RejectsInvalidTokens(t *testing.T) {
+	sort := repository.ListSort{Field: repository.SortByTitle, Ascending: true}
+	valid := encodePageToken(&domain.News{Slug: "a", Title: "A"}, sort)
+
+	// Меняем символ в середине токена: base64 остается корректным, JSON - нет
+	tampered := []byte(valid)
+	tampered[len(tampered)/2] ^= 0x01
+
+	tests := []struct {
+		name  string
+		token string
+		sort  repository.ListSort
+	}{
+		{"not base64", "!!!", sort},
+		{"tampered", string(tampered), sort},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("cursor")), sort},
+		{"without slug", base64.RawURLEncoding.EncodeToString([]byte(`{"o":"title:asc","v":"A"}`)), sort},
+		{"other direction", valid, repository.ListSort{Field: repository.SortByTitle}},
+		{"other field", valid, repository.ListSort{Field: repository.SortByCreatedAt, Ascending: true}},
+	}
+
+	for _, tt := range tests {
+		if _, ok := decodePageToken(tt.token, tt.sort); ok {
+			t.Errorf("%s: expected token to be rejected", tt.name)
+		}
+	}
+}
+
+func TestNewsService_ListRejectsInvalidPageToken(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "a")
+
+	for _, token := range []string{"garbage", encodePageToken(&domain.News{Slug: "a"}, repository.ListSort{Field: repository.SortByTitle})} {
+		_, err := s.GetNewsList(context.Background(), ListParams{Page: 1, Limit: 10, PageToken: token})
+
+		// ValidationError с полем page_token отдается клиенту как InvalidArgument
+		var verr *errors.ValidationError
+		if !stderrors.As(err, &verr) || !hasViolation(err, "page_token") {
+			t.Errorf("Expected page_token violation for %q, got %v", token, err)
+		}
+		if !stderrors.Is(err, errors.ErrInvalidPagination) {
+			t.Errorf("Expected ErrInvalidPagination for %q, got %v", token, err)
+		}
+	}
+}
+
+func TestNewsService_ListBreaksTiesBySlug(t *testing.T) {
+	s, repo := newTestService(t)
+	for _, slug := range []string{"c", "e", "a", "d", "b"} {
+		mustCreate(t, s, slug)
+	}
+	// Одинаковое время создания: порядок и курсор держатся только на slug
+	sameTime := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	for _, news := range repo.news {
+		news.CreatedAt = sameTime
+	}
+
+	params := ListParams{Page: 1, Limit: 2, SkipTotal: true}
+	var slugs []string
+	for pages := 0; pages < 5; pages++ {
+		result, err := s.GetNewsList(context.Background(), params)
+		if err != nil {
+			t.Fatalf("Failed to get news list: %v", err)
+		}
+		slugs = append(slugs, listSlugs(result)...)
+		if result.NextPageToken == "" {
+			break
+		}
+		params.PageToken = result.NextPageToken
+	}
+
+	if strings.Join(slugs, ",") != "e,d,c,b,a" {
+		t.Errorf("Expected ties broken by slug without gaps or duplicates, got %v", slugs)
+	}
+}
+
+func TestNewsService_ListLastPageHasNoToken(t *testing.T) {
+	s, _ := newTestService(t)
+	for _, slug := range []string{"a", "b", "c", "d"} {
+		mustCreate(t, s, slug)
+	}
+
+	// Число новостей кратно размеру страницы: вторая страница полная, но последняя
+	params := ListParams{Page: 1, Limit: 2}
+	first, err := s.GetNewsList(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Failed to get first page: %v", err)
+	}
+	if first.NextPageToken == "" {
+		t.Fatal("Expected next page token on the first page")
+	}
+
+	params.PageToken = first.NextPageToken
+	last, err := s.GetNewsList(context.Background(), params)
+	if err != nil {
+		t.Fatalf("Failed to get last page: %v", err)
+	}
+	if strings.Join(listSlugs(last), ",") != "b,a" {
+		t.Errorf("Expected last page [b a], got %v", listSlugs(last))
+	}
+	if last.NextPageToken != "" {
+		t.Errorf("Expected empty next page token on the last page, got %q", last.NextPageToken)
+	}
+}
+
+func TestNewsService_ListSkipTotal(t *testing.T) {
+	s, repo := newTestService(t)
+	for _, slug := range []string{"a", "b", "c"} {
+		mustCreate(t, s, slug)
+	}
+
+	result, err := s.GetNewsList(context.Background(), ListParams{Page: 1, Limit: 2, SkipTotal: true})
+	if err != nil {
+		t.Fatalf("Failed to get news list: %v", err)
+	}
+	if result.Total != -1 {
+		t.Errorf("Expected total -1 with skip_total, got %d", result.Total)
+	}
+	if repo.lastList.WithTotal {
+		t.Error("Expected repository not to count news with skip_total")
+	}
+
+	result, err = s.GetNewsList(context.Background(), ListParams{Page: 1, Limit: 2})
+	if err != nil {
+		t.Fatalf("Failed to get news list: %v", err)
+	}
+	if result.Total != 3 {
+		t.Errorf("Expected total 3, got %d", result.Total)
+	}
+}
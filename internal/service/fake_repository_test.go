@@ -0,0 +1,483 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"news-service/internal/domain"
+	"news-service/internal/repository"
+	"news-service/pkg/errors"
+)
+
+// fakeRepository - потокобезопасная in-memory реализация repository.NewsRepository
+type fakeRepository struct {
+	mu      sync.Mutex
+	news    map[string]*domain.News
+	aliases map[string]string
+	// revisions хранит ревизии по slug в порядке создания
+	revisions map[string][]*domain.Revision
+	clock     time.Time
+	// lastList - параметры последнего вызова GetList
+	lastList repository.ListOptions
+}
+
+func newFakeRepository() *fakeRepository {
+	return &fakeRepository{
+		news:      make(map[string]*domain.News),
+		aliases:   make(map[string]string),
+		revisions: make(map[string][]*domain.Revision),
+		clock:     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// now возвращает строго возрастающее время, чтобы порядок created_at был детерминирован
+func (r *fakeRepository) now() time.Time {
+	r.clock = r.clock.Add(time.Second)
+	return r.clock
+}
+
+// active возвращает хранимую новость, если она не в корзине
+func (r *fakeRepository) active(slug string) (*domain.News, bool) {
+	news, exists := r.news[slug]
+	if !exists || news.DeletedAt != nil {
+		return nil, false
+	}
+	return news, true
+}
+
+// sorted возвращает копии новостей, подходящих под filter, в порядке списка
+func (r *fakeRepository) sorted(filter func(*domain.News) bool) []*domain.News {
+	var all []*domain.News
+	for _, news := range r.news {
+		if filter(news) {
+			item := *news
+			all = append(all, &item)
+		}
+	}
+	sort.Slice(all, func(i, j int) bool {
+		if !all[i].CreatedAt.Equal(all[j].CreatedAt) {
+			return all[i].CreatedAt.After(all[j].CreatedAt)
+		}
+		return all[i].Slug > all[j].Slug
+	})
+	return all
+}
+
+func hasAnyTag(news *domain.News, tags []string) bool {
+	for _, tag := range tags {
+		if slices.Contains(news.Tags, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func page(all []*domain.News, start, limit int) []*domain.News {
+	if start > len(all) {
+		start = len(all)
+	}
+	end := start + limit
+	if end > len(all) {
+		end = len(all)
+	}
+	return all[start:end]
+}
+
+// addRevision сохраняет снимок текущего состояния новости
+func (r *fakeRepository) addRevision(news *domain.News) {
+	r.revisions[news.Slug] = append(r.revisions[news.Slug], &domain.Revision{
+		Slug:      news.Slug,
+		Version:   news.Version,
+		Title:     news.Title,
+		Content:   news.Content,
+		Author:    news.UpdatedBy,
+		CreatedAt: news.UpdatedAt,
+	})
+}
+
+func (r *fakeRepository) Create(ctx context.Context, news *domain.News) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.news[news.Slug]; exists {
+		return errors.ErrDuplicateSlug
+	}
+	if _, exists := r.aliases[news.Slug]; exists {
+		return errors.ErrDuplicateSlug
+	}
+	news.CreatedAt = r.now()
+	news.UpdatedAt = news.CreatedAt
+	news.Version = 1
+	news.UpdatedBy = news.CreatedBy
+	if news.Status == "" {
+		news.Status = domain.StatusPublished
+	}
+	if news.Status == domain.StatusPublished && news.PublishAt == nil {
+		publishAt := news.CreatedAt
+		news.PublishAt = &publishAt
+	}
+	stored := *news
+	r.news[news.Slug] = &stored
+	r.addRevision(&stored)
+	return nil
+}
+
+// missing повторяет ошибку postgres-репозитория для изменений по отсутствующему slug
+func (r *fakeRepository) missing(slug string) error {
+	if canonical, exists := r.aliases[slug]; exists {
+		return &errors.SlugMovedError{Slug: canonical}
+	}
+	return errors.ErrNewsNotFound
+}
+
+func (r *fakeRepository) GetBySlug(ctx context.Context, slug string) (*domain.News, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	news, exists := r.active(slug)
+	if !exists {
+		news, exists = r.active(r.aliases[slug])
+	}
+	if !exists {
+		return nil, errors.ErrNewsNotFound
+	}
+	result := *news
+	return &result, nil
+}
+
+func (r *fakeRepository) GetList(ctx context.Context, opts repository.ListOptions) ([]*domain.News, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastList = opts
+
+	f := opts.Filter
+	now := time.Now()
+	all := r.sorted(func(news *domain.News) bool {
+		return news.DeletedAt == nil &&
+			(f.IncludeUnpublished || news.IsPublic(now)) &&
+			(f.CreatedFrom.IsZero() || !news.CreatedAt.Before(f.CreatedFrom)) &&
+			(f.CreatedTo.IsZero() || news.CreatedAt.Before(f.CreatedTo)) &&
+			(f.UpdatedFrom.IsZero() || !news.UpdatedAt.Before(f.UpdatedFrom)) &&
+			(f.UpdatedTo.IsZero() || news.UpdatedAt.Before(f.UpdatedTo)) &&
+			strings.HasPrefix(strings.ToLower(news.Title), strings.ToLower(f.TitlePrefix)) &&
+			(len(f.Tags) == 0 || hasAnyTag(news, f.Tags)) &&
+			(f.CreatedBy == "" || news.CreatedBy == f.CreatedBy) &&
+			(f.UpdatedBy == "" || news.UpdatedBy == f.UpdatedBy)
+	})
+
+	// before сообщает, что a идет в списке раньше b
+	before := func(a, b *domain.News) bool {
+		var cmp int
+		switch opts.Sort.Field {
+		case repository.SortByTitle:
+			cmp = strings.Compare(a.Title, b.Title)
+		case repository.SortByUpdatedAt:
+			cmp = a.UpdatedAt.Compare(b.UpdatedAt)
+		default:
+			cmp = a.CreatedAt.Compare(b.CreatedAt)
+		}
+		if cmp == 0 {
+			cmp = strings.Compare(a.Slug, b.Slug)
+		}
+		if opts.Sort.Ascending {
+			return cmp < 0
+		}
+		return cmp > 0
+	}
+	sort.Slice(all, func(i, j int) bool { return before(all[i], all[j]) })
+
+	var total int64
+	if opts.WithTotal {
+		total = int64(len(all))
+	}
+
+	start := opts.Offset
+	if opts.After != nil {
+		cursor := &domain.News{
+			Slug:      opts.After.Slug,
+			Title:     opts.After.Title,
+			CreatedAt: opts.After.Time,
+			UpdatedAt: opts.After.Time,
+		}
+		start = len(all)
+		for i, news := range all {
+			if before(cursor, news) {
+				start = i
+				break
+			}
+		}
+	}
+
+	return page(all, start, opts.Limit), total, nil
+}
+
+func (r *fakeRepository) Update(ctx context.Context, slug string, patch domain.NewsPatch) (*domain.News, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.active(slug)
+	if !exists {
+		return nil, r.missing(slug)
+	}
+	if patch.ExpectedVersion > 0 && patch.ExpectedVersion != stored.Version {
+		return nil, errors.ErrConflict
+	}
+	if patch.Title != nil {
+		stored.Title = *patch.Title
+	}
+	if patch.Content != nil {
+		stored.Content = *patch.Content
+	}
+	stored.UpdatedAt = r.now()
+	stored.UpdatedBy = patch.Author
+	stored.Version++
+	r.addRevision(stored)
+
+	result := *stored
+	return &result, nil
+}
+
+func (r *fakeRepository) Delete(ctx context.Context, slug string, expectedVersion int64, updatedBy string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.active(slug)
+	if !exists {
+		return r.missing(slug)
+	}
+	if expectedVersion > 0 && expectedVersion != stored.Version {
+		return errors.ErrConflict
+	}
+	deletedAt := r.now()
+	stored.DeletedAt = &deletedAt
+	stored.UpdatedAt = deletedAt
+	stored.UpdatedBy = updatedBy
+	stored.Version++
+	r.addRevision(stored)
+	return nil
+}
+
+func (r *fakeRepository) Restore(ctx context.Context, slug string, updatedBy string) (*domain.News, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.news[slug]
+	if !exists || stored.DeletedAt == nil {
+		return nil, r.missing(slug)
+	}
+	stored.DeletedAt = nil
+	stored.UpdatedAt = r.now()
+	stored.UpdatedBy = updatedBy
+	stored.Version++
+	r.addRevision(stored)
+
+	result := *stored
+	return &result, nil
+}
+
+func (r *fakeRepository) ListDeleted(ctx context.Context, offset, limit int) ([]*domain.News, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	all := r.sorted(func(news *domain.News) bool { return news.DeletedAt != nil })
+	return page(all, offset, limit), int64(len(all)), nil
+}
+
+func (r *fakeRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged int64
+	for slug, news := range r.news {
+		if news.DeletedAt != nil && news.DeletedAt.Before(deletedBefore) {
+			delete(r.news, slug)
+			delete(r.revisions, slug)
+			purged++
+		}
+	}
+	return purged, nil
+}
+
+// Search ищет подстроку без учета регистра; ранг у всех результатов одинаковый
+func (r *fakeRepository) Search(ctx context.Context, query string, offset, limit int) ([]*domain.SearchHit, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	query = strings.ToLower(query)
+	now := time.Now()
+	all := r.sorted(func(news *domain.News) bool {
+		return news.IsPublic(now) &&
+			(strings.Contains(strings.ToLower(news.Title), query) || strings.Contains(strings.ToLower(news.Content), query))
+	})
+
+	found := page(all, offset, limit)
+	hits := make([]*domain.SearchHit, len(found))
+	for i, news := range found {
+		hits[i] = &domain.SearchHit{News: news, Rank: 1, TitleHighlight: news.Title, Snippet: news.Content}
+	}
+	return hits, int64(len(all)), nil
+}
+
+func (r *fakeRepository) Rename(ctx context.Context, slug, newSlug string, expectedVersion int64, updatedBy string) (*domain.News, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.active(slug)
+	if !exists {
+		return nil, r.missing(slug)
+	}
+	if expectedVersion > 0 && expectedVersion != stored.Version {
+		return nil, errors.ErrConflict
+	}
+	if _, exists := r.news[newSlug]; exists {
+		return nil, errors.ErrDuplicateSlug
+	}
+	if owner, exists := r.aliases[newSlug]; exists && owner != slug {
+		return nil, errors.ErrDuplicateSlug
+	}
+
+	delete(r.aliases, newSlug)
+	for old, target := range r.aliases {
+		if target == slug {
+			r.aliases[old] = newSlug
+		}
+	}
+	r.aliases[slug] = newSlug
+
+	delete(r.news, slug)
+	for _, revision := range r.revisions[slug] {
+		revision.Slug = newSlug
+	}
+	r.revisions[newSlug] = r.revisions[slug]
+	delete(r.revisions, slug)
+	stored.Slug = newSlug
+	stored.UpdatedAt = r.now()
+	stored.UpdatedBy = updatedBy
+	stored.Version++
+	r.news[newSlug] = stored
+	r.addRevision(stored)
+
+	result := *stored
+	return &result, nil
+}
+
+func (r *fakeRepository) SetTags(ctx context.Context, slug string, tags []string) (*domain.News, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.active(slug)
+	if !exists {
+		return nil, r.missing(slug)
+	}
+	stored.Tags = slices.Clone(tags)
+
+	result := *stored
+	return &result, nil
+}
+
+func (r *fakeRepository) ListTags(ctx context.Context) ([]domain.TagCount, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[string]int64)
+	now := time.Now()
+	for _, news := range r.news {
+		if !news.IsPublic(now) {
+			continue
+		}
+		for _, tag := range news.Tags {
+			counts[tag]++
+		}
+	}
+
+	tags := make([]domain.TagCount, 0, len(counts))
+	for name, count := range counts {
+		tags = append(tags, domain.TagCount{Name: name, Count: count})
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if tags[i].Count != tags[j].Count {
+			return tags[i].Count > tags[j].Count
+		}
+		return tags[i].Name < tags[j].Name
+	})
+	return tags, nil
+}
+
+func (r *fakeRepository) SetStatus(ctx context.Context, slug string, status domain.NewsStatus, publishAt *time.Time, expectedVersion int64, updatedBy string) (*domain.News, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.active(slug)
+	if !exists {
+		return nil, r.missing(slug)
+	}
+	if expectedVersion > 0 && expectedVersion != stored.Version {
+		return nil, errors.ErrConflict
+	}
+	stored.Status = status
+	if publishAt != nil {
+		at := *publishAt
+		stored.PublishAt = &at
+	}
+	stored.UpdatedAt = r.now()
+	stored.UpdatedBy = updatedBy
+	stored.Version++
+	r.addRevision(stored)
+
+	result := *stored
+	return &result, nil
+}
+
+func (r *fakeRepository) PublishDue(ctx context.Context, now time.Time) ([]string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var slugs []string
+	for slug, news := range r.news {
+		if news.DeletedAt == nil && news.Status == domain.StatusScheduled && !news.PublishAt.After(now) {
+			news.Status = domain.StatusPublished
+			news.UpdatedAt = r.now()
+			news.Version++
+			r.addRevision(news)
+			slugs = append(slugs, slug)
+		}
+	}
+	return slugs, nil
+}
+
+func (r *fakeRepository) ListRevisions(ctx context.Context, slug string, offset, limit int) ([]*domain.Revision, int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.active(slug); !exists {
+		return nil, 0, errors.ErrNewsNotFound
+	}
+	revisions := slices.Clone(r.revisions[slug])
+	slices.Reverse(revisions)
+
+	if offset > len(revisions) {
+		offset = len(revisions)
+	}
+	end := min(offset+limit, len(revisions))
+	return revisions[offset:end], int64(len(revisions)), nil
+}
+
+func (r *fakeRepository) GetRevision(ctx context.Context, slug string, version int64) (*domain.Revision, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.active(slug); !exists {
+		return nil, errors.ErrRevisionNotFound
+	}
+	for _, revision := range r.revisions[slug] {
+		if revision.Version == version {
+			result := *revision
+			return &result, nil
+		}
+	}
+	return nil, errors.ErrRevisionNotFound
+}
@@ -2,143 +2,323 @@ package service
 
 import (
 	"context"
+	stderrors "errors"
 	"fmt"
+	"log/slog"
+	"sort"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"news-service/internal/auth"
 	"news-service/internal/cache"
 	"news-service/internal/domain"
 	"news-service/internal/repository"
+	"news-service/pkg/diff"
 	"news-service/pkg/errors"
+	"news-service/pkg/slugify"
 )
 
 type NewsService struct {
-	repo  repository.NewsRepository
-	cache *cache.Cache
+	repo   repository.NewsRepository
+	cache  *cache.Cache
+	logger *slog.Logger
 }
 
-func NewNewsService(repo repository.NewsRepository, cache *cache.Cache) *NewsService {
-	return &NewsService{
-		repo:  repo,
-		cache: cache,
+// Option настраивает NewsService
+type Option func(*NewsService)
+
+// WithLogger задает логгер сервиса; по умолчанию используется slog.Default()
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *NewsService) {
+		s.logger = logger
+	}
+}
+
+func NewNewsService(repo repository.NewsRepository, cache *cache.Cache, opts ...Option) *NewsService {
+	s := &NewsService{
+		repo:   repo,
+		cache:  cache,
+		logger: slog.Default(),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
-func (s *NewsService) CreateNews(ctx context.Context, slug, title, content string) (*domain.News, error) {
+// Publication - желаемое состояние публикации новости.
+// Нулевое значение означает немедленную публикацию.
+type Publication struct {
+	Status domain.NewsStatus
+	// PublishAt в будущем откладывает публикацию до этого времени
+	PublishAt time.Time
+}
+
+// CreateNews создает новость. Если slug не передан, он генерируется из
+// заголовка; сгенерированный slug возвращается в news.Slug.
+func (s *NewsService) CreateNews(ctx context.Context, slug, title, content string, publication Publication) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.CreateNews")
+	defer span.End()
+
+	generated := slug == ""
+	if generated {
+		slug = generateSlug(title)
+	}
+
 	// Валидация входных данных
 	if err := s.validateNewsData(slug, title, content); err != nil {
 		return nil, err
 	}
+	verr := &errors.ValidationError{}
+	status, publishAt := resolvePublication(verr, publication, time.Now())
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
 
 	news := &domain.News{
-		Slug:    slug,
-		Title:   title,
-		Content: content,
+		Slug:      slug,
+		Title:     title,
+		Content:   content,
+		Status:    status,
+		PublishAt: publishAt,
+		CreatedBy: authorFromContext(ctx),
 	}
 
 	// Сохраняем в БД
-	if err := s.repo.Create(ctx, news); err != nil {
+	var err error
+	if generated {
+		err = s.createWithGeneratedSlug(ctx, news)
+	} else {
+		err = s.repo.Create(ctx, news)
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	// Добавляем в кеш
-	s.cache.Set(s.getCacheKey(slug), news)
+	s.cache.Set(s.getCacheKey(news.Slug), news)
+
+	// Новая новость должна сразу появиться в списках
+	s.invalidateListCache()
 
 	return news, nil
 }
 
-func (s *NewsService) GetNews(ctx context.Context, slug string) (*domain.News, error) {
-	if slug == "" {
-		return nil, errors.ErrInvalidSlug
+// maxSlugAttempts ограничивает число суффиксов, перебираемых при совпадении slug
+const maxSlugAttempts = 20
+
+// createWithGeneratedSlug сохраняет новость, добавляя к занятому slug
+// суффиксы -2, -3 и так далее
+func (s *NewsService) createWithGeneratedSlug(ctx context.Context, news *domain.News) error {
+	base := news.Slug
+	for attempt := 1; attempt <= maxSlugAttempts; attempt++ {
+		if attempt > 1 {
+			suffix := fmt.Sprintf("-%d", attempt)
+			news.Slug = slugify.Truncate(base, maxSlugLength-len(suffix)) + suffix
+		}
+
+		err := s.repo.Create(ctx, news)
+		if !stderrors.Is(err, errors.ErrDuplicateSlug) {
+			return err
+		}
+	}
+	return errors.ErrDuplicateSlug
+}
+
+// generateSlug строит slug из заголовка; заголовок без букв и цифр дает "news"
+func generateSlug(title string) string {
+	if slug := slugify.Make(title); slug != "" {
+		return slug
+	}
+	return "news"
+}
+
+// GetNews возвращает новость по slug. Неопубликованные новости видны
+// только с includeUnpublished, для остальных они не существуют.
+func (s *NewsService) GetNews(ctx context.Context, slug string, includeUnpublished bool) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.GetNews")
+	defer span.End()
+
+	news, err := s.getNews(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if !includeUnpublished && !news.IsPublic(time.Now()) {
+		return nil, errors.ErrNewsNotFound
+	}
+	return news, nil
+}
+
+func (s *NewsService) getNews(ctx context.Context, slug string) (*domain.News, error) {
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	if err := verr.Err(); err != nil {
+		return nil, err
 	}
 
 	// Сначала проверяем кеш
 	cacheKey := s.getCacheKey(slug)
-	if cached, exists := s.cache.Get(cacheKey); exists {
+	if cached, exists := s.cacheGet(ctx, cacheKey); exists {
 		if news, ok := cached.(*domain.News); ok {
 			return news, nil
 		}
 	}
 
 	// Если в кеше нет, запрашиваем из БД
+	ctx, reads := repository.TrackReads(ctx)
 	news, err := s.repo.GetBySlug(ctx, slug)
 	if err != nil {
 		return nil, err
 	}
 
-	// Кешируем результат
-	s.cache.Set(cacheKey, news)
+	// Кешируем результат под актуальным slug: если запрошен старый slug
+	// переименованной новости, алиас в кеш не попадает и не устареет.
+	// Ответ отстающей реплики не кешируем, иначе он переживет запись.
+	if !reads.MayBeStale() {
+		s.cache.Set(s.getCacheKey(news.Slug), news)
+	}
 
 	return news, nil
 }
 
-func (s *NewsService) GetNewsList(ctx context.Context, page, limit int) ([]*domain.News, int64, error) {
-	// Валидация пагинации
-	if page < 1 || limit < 1 || limit > 100 {
-		return nil, 0, errors.ErrInvalidPagination
+// ListParams - параметры запроса списка новостей.
+// Если задан PageToken, используется keyset-пагинация и Page игнорируется.
+type ListParams struct {
+	Page      int
+	Limit     int
+	PageToken string
+	SkipTotal bool
+	Filter    repository.ListFilter
+	Sort      repository.ListSort
+}
+
+// ListResult - страница списка новостей
+type ListResult struct {
+	News []*domain.News
+	// Total равен -1, если подсчет был отключен через SkipTotal
+	Total         int64
+	NextPageToken string
+}
+
+func (s *NewsService) GetNewsList(ctx context.Context, params ListParams) (*ListResult, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.GetNewsList")
+	defer span.End()
+
+	// Теги фильтра приводим к каноническому виду до построения ключа кеша
+	params.Filter.Tags = normalizeTags(params.Filter.Tags)
+
+	opts := repository.ListOptions{
+		// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница
+		Limit:     params.Limit + 1,
+		WithTotal: !params.SkipTotal,
+		Filter:    params.Filter,
+		Sort:      params.Sort,
 	}
 
-	offset := (page - 1) * limit
+	// Валидация пагинации, фильтра и сортировки
+	verr := &errors.ValidationError{}
+	if params.PageToken != "" {
+		cursor, ok := decodePageToken(params.PageToken, params.Sort)
+		if !ok {
+			verr.Add("page_token", errors.RuleFormat, 0,
+				"page_token is malformed or was issued for another sort order")
+		}
+		opts.After = cursor
+	} else {
+		if params.Page < 1 {
+			verr.Add("page", errors.RuleMinValue, 1, "page must be at least 1")
+		}
+		opts.Offset = (params.Page - 1) * params.Limit
+	}
+	validateLimit(verr, params.Limit)
+	validateListFilter(verr, params.Filter)
+	validateListSort(verr, params.Sort)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
 
 	// Проверяем кеш для списка
-	listCacheKey := s.getListCacheKey(page, limit)
-	if cached, exists := s.cache.Get(listCacheKey); exists {
-		if result, ok := cached.(ListCacheItem); ok {
-			return result.News, result.Total, nil
+	listCacheKey := s.getListCacheKey(params)
+	if cached, exists := s.cacheGet(ctx, listCacheKey); exists {
+		if result, ok := cached.(*ListResult); ok {
+			return result, nil
 		}
 	}
 
 	// Запрашиваем из БД
-	newsList, total, err := s.repo.GetList(ctx, offset, limit)
+	ctx, reads := repository.TrackReads(ctx)
+	newsList, total, err := s.repo.GetList(ctx, opts)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+
+	result := &ListResult{News: newsList, Total: total}
+	if params.SkipTotal {
+		result.Total = -1
+	}
+	if len(newsList) > params.Limit {
+		result.News = newsList[:params.Limit]
+		result.NextPageToken = encodePageToken(result.News[params.Limit-1], params.Sort)
+	}
+
+	// Ответ отстающей реплики клиенту отдаем, но не кешируем
+	if reads.MayBeStale() {
+		return result, nil
 	}
 
 	// Кешируем результат
-	s.cache.Set(listCacheKey, ListCacheItem{
-		News:  newsList,
-		Total: total,
-	})
+	s.cache.Set(listCacheKey, result)
 
 	// Также кешируем индивидуальные новости
-	for _, news := range newsList {
+	for _, news := range result.News {
 		s.cache.Set(s.getCacheKey(news.Slug), news)
 	}
 
-	return newsList, total, nil
+	return result, nil
 }
 
-func (s *NewsService) UpdateNews(ctx context.Context, slug, title, content string) (*domain.News, error) {
+// UpdateNews изменяет только поля, заданные в патче
+func (s *NewsService) UpdateNews(ctx context.Context, slug string, patch domain.NewsPatch) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.UpdateNews")
+	defer span.End()
+
 	// Валидация входных данных
-	if err := s.validateNewsData(slug, title, content); err != nil {
+	if err := s.validateNewsPatch(slug, patch); err != nil {
 		return nil, err
 	}
 
-	news := &domain.News{
-		Title:   title,
-		Content: content,
-	}
-
-	// Обновляем в БД
-	if err := s.repo.Update(ctx, slug, news); err != nil {
+	// Обновляем в БД; изменение и ревизия записываются от имени автора запроса
+	patch.Author = authorFromContext(ctx)
+	news, err := s.repo.Update(ctx, slug, patch)
+	if err != nil {
 		return nil, err
 	}
 
 	// Инвалидируем кеш для этой новости
 	s.cache.Delete(s.getCacheKey(slug))
 
-	// Инвалидируем кеш списков (упрощенный подход)
+	// Инвалидируем кеш списков
 	s.invalidateListCache()
 
 	return news, nil
 }
 
-func (s *NewsService) DeleteNews(ctx context.Context, slug string) error {
-	if slug == "" {
-		return errors.ErrInvalidSlug
+// DeleteNews удаляет новость; expectedVersion > 0 включает проверку версии
+func (s *NewsService) DeleteNews(ctx context.Context, slug string, expectedVersion int64) error {
+	ctx, span := tracer.Start(ctx, "NewsService.DeleteNews")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateExpectedVersion(verr, expectedVersion)
+	if err := verr.Err(); err != nil {
+		return err
 	}
 
 	// Удаляем из БД
-	if err := s.repo.Delete(ctx, slug); err != nil {
+	if err := s.repo.Delete(ctx, slug, expectedVersion, authorFromContext(ctx)); err != nil {
 		return err
 	}
 
@@ -151,44 +331,627 @@ func (s *NewsService) DeleteNews(ctx context.Context, slug string) error {
 	return nil
 }
 
+// RenameNews меняет slug новости; старый slug продолжает открывать новость
+func (s *NewsService) RenameNews(ctx context.Context, slug, newSlug string, expectedVersion int64) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.RenameNews")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateSlug(verr, "new_slug", newSlug)
+	if slug != "" && slug == newSlug {
+		verr.Add("new_slug", errors.RuleAllowedValues, 0, "new_slug must differ from slug")
+	}
+	validateExpectedVersion(verr, expectedVersion)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	news, err := s.repo.Rename(ctx, slug, newSlug, expectedVersion, authorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Delete(s.getCacheKey(slug))
+	s.cache.Set(s.getCacheKey(news.Slug), news)
+	s.invalidateListCache()
+
+	return news, nil
+}
+
+// maxSearchQueryLength ограничивает длину поискового запроса в символах
+const maxSearchQueryLength = 256
+
+// SearchNews выполняет полнотекстовый поиск. Результаты не кешируются:
+// запросы слишком разнообразны, чтобы кеш давал попадания.
+func (s *NewsService) SearchNews(ctx context.Context, query string, page, limit int) ([]*domain.SearchHit, int64, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.SearchNews")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	query = strings.TrimSpace(query)
+	if query == "" {
+		verr.Add("query", errors.RuleRequired, 0, "query is required")
+	} else if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		verr.Add("query", errors.RuleMaxLength, maxSearchQueryLength,
+			fmt.Sprintf("query exceeds %d characters", maxSearchQueryLength))
+	}
+	validatePagination(verr, page, limit)
+	if err := verr.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.Search(ctx, query, (page-1)*limit, limit)
+}
+
+// RestoreNews возвращает новость из корзины. Для кеша это равносильно
+// созданию: новость снова должна появиться в списках.
+func (s *NewsService) RestoreNews(ctx context.Context, slug string) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.RestoreNews")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	news, err := s.repo.Restore(ctx, slug, authorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(s.getCacheKey(news.Slug), news)
+	s.invalidateListCache()
+
+	return news, nil
+}
+
+// ListDeletedNews возвращает содержимое корзины. Корзина не кешируется.
+func (s *NewsService) ListDeletedNews(ctx context.Context, page, limit int) ([]*domain.News, int64, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.ListDeletedNews")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validatePagination(verr, page, limit)
+	if err := verr.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListDeleted(ctx, (page-1)*limit, limit)
+}
+
+// SetNewsTags заменяет теги новости. Теги приводятся к нижнему регистру,
+// повторы отбрасываются.
+func (s *NewsService) SetNewsTags(ctx context.Context, slug string, tags []string) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.SetNewsTags")
+	defer span.End()
+
+	tags = normalizeTags(tags)
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateTags(verr, tags)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	news, err := s.repo.SetTags(ctx, slug, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(s.getCacheKey(news.Slug), news)
+	s.invalidateListCache()
+
+	return news, nil
+}
+
+// ListTags возвращает используемые теги с количеством новостей.
+// Счетчики меняются вместе со списками, поэтому кешируются в том же поколении.
+func (s *NewsService) ListTags(ctx context.Context) ([]domain.TagCount, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.ListTags")
+	defer span.End()
+
+	cacheKey := fmt.Sprintf("%s:%d:tags", listCacheNamespace, s.cache.Generation(listCacheNamespace))
+	if cached, exists := s.cacheGet(ctx, cacheKey); exists {
+		if tags, ok := cached.([]domain.TagCount); ok {
+			return tags, nil
+		}
+	}
+
+	ctx, reads := repository.TrackReads(ctx)
+	tags, err := s.repo.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reads.MayBeStale() {
+		s.cache.Set(cacheKey, tags)
+	}
+	return tags, nil
+}
+
+// ListRevisions возвращает историю изменений новости, последние правки первыми
+func (s *NewsService) ListRevisions(ctx context.Context, slug string, page, limit int) ([]*domain.Revision, int64, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.ListRevisions")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validatePagination(verr, page, limit)
+	if err := verr.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return s.repo.ListRevisions(ctx, slug, (page-1)*limit, limit)
+}
+
+// GetRevision возвращает ревизию новости с указанной версией
+func (s *NewsService) GetRevision(ctx context.Context, slug string, version int64) (*domain.Revision, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.GetRevision")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateVersion(verr, "version", version)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetRevision(ctx, slug, version)
+}
+
+// RevisionDiff - построчное сравнение двух ревизий новости
+type RevisionDiff struct {
+	From    *domain.Revision
+	To      *domain.Revision
+	Title   []diff.Line
+	Content []diff.Line
+}
+
+// DiffRevisions сравнивает ревизии fromVersion и toVersion
+func (s *NewsService) DiffRevisions(ctx context.Context, slug string, fromVersion, toVersion int64) (*RevisionDiff, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.DiffRevisions")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateVersion(verr, "from_version", fromVersion)
+	validateVersion(verr, "to_version", toVersion)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	from, err := s.repo.GetRevision(ctx, slug, fromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.repo.GetRevision(ctx, slug, toVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ревизии, сохраненные до ограничения длины содержимого, могут быть
+	// сколь угодно большими; сравнивать их слишком дорого
+	verr = &errors.ValidationError{}
+	validateDiffSize(verr, "from_version", from)
+	validateDiffSize(verr, "to_version", to)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	return &RevisionDiff{
+		From:    from,
+		To:      to,
+		Title:   diff.Lines(from.Title, to.Title),
+		Content: diff.Lines(from.Content, to.Content),
+	}, nil
+}
+
+func validateDiffSize(verr *errors.ValidationError, field string, revision *domain.Revision) {
+	if diff.CountLines(revision.Content) > maxDiffLines {
+		verr.Add(field, errors.RuleMaxLength, maxDiffLines,
+			fmt.Sprintf("revision %d has more than %d lines to compare", revision.Version, maxDiffLines))
+	}
+}
+
+// RollbackNews возвращает заголовок и содержимое ревизии version.
+// Откат - обычное обновление: он получает новую версию и свою ревизию.
+func (s *NewsService) RollbackNews(ctx context.Context, slug string, version, expectedVersion int64) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.RollbackNews")
+	defer span.End()
+
+	revision, err := s.GetRevision(ctx, slug, version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.UpdateNews(ctx, slug, domain.NewsPatch{
+		Title:           &revision.Title,
+		Content:         &revision.Content,
+		ExpectedVersion: expectedVersion,
+	})
+}
+
+// PublishNews публикует новость; publishAt в будущем откладывает публикацию,
+// нулевое значение публикует сразу
+func (s *NewsService) PublishNews(ctx context.Context, slug string, publishAt time.Time, expectedVersion int64) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.PublishNews")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateExpectedVersion(verr, expectedVersion)
+	status, at := resolvePublication(verr, Publication{Status: domain.StatusPublished, PublishAt: publishAt}, time.Now())
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	return s.setStatus(ctx, slug, status, at, expectedVersion)
+}
+
+// UnpublishNews снимает новость с публикации: возвращает в черновики
+// или, если archive, переносит в архив
+func (s *NewsService) UnpublishNews(ctx context.Context, slug string, archive bool, expectedVersion int64) (*domain.News, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.UnpublishNews")
+	defer span.End()
+
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateExpectedVersion(verr, expectedVersion)
+	if err := verr.Err(); err != nil {
+		return nil, err
+	}
+
+	status := domain.StatusDraft
+	if archive {
+		status = domain.StatusArchived
+	}
+	return s.setStatus(ctx, slug, status, nil, expectedVersion)
+}
+
+func (s *NewsService) setStatus(ctx context.Context, slug string, status domain.NewsStatus, publishAt *time.Time, expectedVersion int64) (*domain.News, error) {
+	news, err := s.repo.SetStatus(ctx, slug, status, publishAt, expectedVersion, authorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(s.getCacheKey(news.Slug), news)
+	s.invalidateListCache()
+
+	return news, nil
+}
+
+// PublishScheduledNews публикует отложенные новости, время которых наступило
+func (s *NewsService) PublishScheduledNews(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.PublishScheduledNews")
+	defer span.End()
+
+	slugs, err := s.repo.PublishDue(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, slug := range slugs {
+		s.cache.Delete(s.getCacheKey(slug))
+	}
+	if len(slugs) > 0 {
+		s.invalidateListCache()
+	}
+
+	return len(slugs), nil
+}
+
+// RunScheduler раз в interval публикует отложенные новости.
+// Блокируется до отмены ctx.
+func (s *NewsService) RunScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			published, err := s.PublishScheduledNews(ctx)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "Failed to publish scheduled news", "error", err)
+				continue
+			}
+			if published > 0 {
+				s.logger.InfoContext(ctx, "Published scheduled news", "count", published)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// PurgeDeletedNews окончательно удаляет новости, пролежавшие в корзине дольше retention
+func (s *NewsService) PurgeDeletedNews(ctx context.Context, retention time.Duration) (int64, error) {
+	ctx, span := tracer.Start(ctx, "NewsService.PurgeDeletedNews")
+	defer span.End()
+
+	return s.repo.Purge(ctx, time.Now().Add(-retention))
+}
+
+// RunPurger раз в interval очищает корзину от новостей старше retention.
+// Блокируется до отмены ctx.
+func (s *NewsService) RunPurger(ctx context.Context, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			purged, err := s.PurgeDeletedNews(ctx, retention)
+			if err != nil {
+				s.logger.ErrorContext(ctx, "Failed to purge deleted news", "error", err)
+				continue
+			}
+			if purged > 0 {
+				s.logger.InfoContext(ctx, "Purged deleted news", "count", purged)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+const (
+	maxSlugLength  = 255
+	maxTitleLength = 500
+	// maxContentLength ограничивает размер новости и ее ревизий
+	maxContentLength = 100_000
+	// maxDiffLines ограничивает число строк ревизии для DiffRevisions: время
+	// сравнения растет как произведение длины текста на число изменений
+	maxDiffLines   = 5000
+	maxPageLimit   = 100
+	maxTagLength   = 64
+	maxTagsPerNews = 20
+	// maxAuthorLength совпадает с колонками created_by и updated_by
+	maxAuthorLength = 255
+)
+
+// validateNewsData проверяет все поля сразу и возвращает *errors.ValidationError
+// со списком нарушений. Длина считается в символах, а не в байтах.
 func (s *NewsService) validateNewsData(slug, title, content string) error {
-	if slug == "" || len(slug) > 255 {
-		return errors.ErrInvalidSlug
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	validateTitle(verr, title)
+	validateContent(verr, content)
+	return verr.Err()
+}
+
+// validateNewsPatch проверяет только те поля, которые патч изменяет
+func (s *NewsService) validateNewsPatch(slug string, patch domain.NewsPatch) error {
+	verr := &errors.ValidationError{}
+	validateSlug(verr, "slug", slug)
+	if patch.IsEmpty() {
+		verr.Add("update_mask", errors.RuleRequired, 0, "at least one field must be updated")
+	}
+	if patch.Title != nil {
+		validateTitle(verr, *patch.Title)
 	}
+	if patch.Content != nil {
+		validateContent(verr, *patch.Content)
+	}
+	validateExpectedVersion(verr, patch.ExpectedVersion)
+	return verr.Err()
+}
+
+func validateExpectedVersion(verr *errors.ValidationError, version int64) {
+	if version < 0 {
+		verr.Add("expected_version", errors.RuleMinValue, 0, "expected_version must not be negative")
+	}
+}
 
-	if title == "" || len(title) > 500 {
-		return errors.ErrInvalidTitle
+func validateVersion(verr *errors.ValidationError, field string, version int64) {
+	if version < 1 {
+		verr.Add(field, errors.RuleMinValue, 1, field+" must be at least 1")
 	}
+}
+
+func validateTitle(verr *errors.ValidationError, title string) {
+	if title == "" {
+		verr.Add("title", errors.RuleRequired, 0, "title is required")
+	} else if utf8.RuneCountInString(title) > maxTitleLength {
+		verr.Add("title", errors.RuleMaxLength, maxTitleLength,
+			fmt.Sprintf("title exceeds %d characters", maxTitleLength))
+	}
+}
 
+func validateContent(verr *errors.ValidationError, content string) {
 	if content == "" {
-		return errors.ErrInvalidContent
+		verr.Add("content", errors.RuleRequired, 0, "content is required")
+	} else if utf8.RuneCountInString(content) > maxContentLength {
+		verr.Add("content", errors.RuleMaxLength, maxContentLength,
+			fmt.Sprintf("content exceeds %d characters", maxContentLength))
+	}
+}
+
+func validateSlug(verr *errors.ValidationError, field, slug string) {
+	if slug == "" {
+		verr.Add(field, errors.RuleRequired, 0, field+" is required")
+		return
+	}
+
+	if utf8.RuneCountInString(slug) > maxSlugLength {
+		verr.Add(field, errors.RuleMaxLength, maxSlugLength,
+			fmt.Sprintf("%s exceeds %d characters", field, maxSlugLength))
 	}
 
 	// Простая валидация slug (только буквы, цифры, дефисы)
-	slug = strings.ToLower(slug)
-	for _, char := range slug {
+	for _, char := range strings.ToLower(slug) {
 		if !((char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') || char == '-' || char == '_') {
-			return errors.ErrInvalidSlug
+			verr.Add(field, errors.RulePattern, 0,
+				field+" may contain only latin letters, digits, '-' and '_'")
+			return
 		}
 	}
+}
 
-	return nil
+func validatePagination(verr *errors.ValidationError, page, limit int) {
+	if page < 1 {
+		verr.Add("page", errors.RuleMinValue, 1, "page must be at least 1")
+	}
+	validateLimit(verr, limit)
+}
+
+func validateListFilter(verr *errors.ValidationError, filter repository.ListFilter) {
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && !filter.CreatedFrom.Before(filter.CreatedTo) {
+		verr.Add("created_before", errors.RuleMinValue, 0, "created_before must be later than created_after")
+	}
+	if !filter.UpdatedFrom.IsZero() && !filter.UpdatedTo.IsZero() && !filter.UpdatedFrom.Before(filter.UpdatedTo) {
+		verr.Add("updated_before", errors.RuleMinValue, 0, "updated_before must be later than updated_after")
+	}
+	if utf8.RuneCountInString(filter.TitlePrefix) > maxTitleLength {
+		verr.Add("title_prefix", errors.RuleMaxLength, maxTitleLength,
+			fmt.Sprintf("title_prefix exceeds %d characters", maxTitleLength))
+	}
+	validateTags(verr, filter.Tags)
+	validateAuthor(verr, "created_by", filter.CreatedBy)
+	validateAuthor(verr, "updated_by", filter.UpdatedBy)
+}
+
+func validateAuthor(verr *errors.ValidationError, field, author string) {
+	if utf8.RuneCountInString(author) > maxAuthorLength {
+		verr.Add(field, errors.RuleMaxLength, maxAuthorLength,
+			fmt.Sprintf("%s exceeds %d characters", field, maxAuthorLength))
+	}
+}
+
+// authorFromContext возвращает автора изменения: пользователя запроса или
+// пустую строку, если он неизвестен
+func authorFromContext(ctx context.Context) string {
+	identity, _ := auth.FromContext(ctx)
+	return identity.Subject
+}
+
+// resolvePublication определяет статус и время публикации для сохранения.
+// Публикация с временем в будущем становится отложенной.
+func resolvePublication(verr *errors.ValidationError, p Publication, now time.Time) (domain.NewsStatus, *time.Time) {
+	switch p.Status {
+	case "", domain.StatusPublished:
+		if p.PublishAt.IsZero() {
+			return domain.StatusPublished, &now
+		}
+		if p.PublishAt.After(now) {
+			return domain.StatusScheduled, &p.PublishAt
+		}
+		return domain.StatusPublished, &p.PublishAt
+	case domain.StatusScheduled:
+		if !p.PublishAt.After(now) {
+			verr.Add("publish_at", errors.RuleMinValue, 0, "publish_at must be in the future for scheduled news")
+		}
+		return domain.StatusScheduled, &p.PublishAt
+	case domain.StatusDraft, domain.StatusArchived:
+		if !p.PublishAt.IsZero() {
+			verr.Add("publish_at", errors.RuleAllowedValues, 0,
+				fmt.Sprintf("publish_at can not be set for %s news", p.Status))
+		}
+		return p.Status, nil
+	default:
+		verr.Add("status", errors.RuleAllowedValues, 0, fmt.Sprintf("unsupported status %q", p.Status))
+		return "", nil
+	}
+}
+
+// normalizeTags приводит теги к нижнему регистру, убирает пробелы по краям
+// и повторы, сортирует по алфавиту
+func normalizeTags(tags []string) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(tags))
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// validateTags проверяет уже нормализованные теги
+func validateTags(verr *errors.ValidationError, tags []string) {
+	if len(tags) > maxTagsPerNews {
+		verr.Add("tags", errors.RuleMaxLength, maxTagsPerNews,
+			fmt.Sprintf("tags exceed %d items", maxTagsPerNews))
+	}
+	for _, tag := range tags {
+		if tag == "" {
+			verr.Add("tags", errors.RuleRequired, 0, "tag must not be empty")
+			return
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			verr.Add("tags", errors.RuleMaxLength, maxTagLength,
+				fmt.Sprintf("tag %q exceeds %d characters", tag, maxTagLength))
+			return
+		}
+		for _, r := range tag {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' && r != '_' && r != ' ' {
+				verr.Add("tags", errors.RulePattern, 0,
+					fmt.Sprintf("tag %q may contain only letters, digits, spaces, '-' and '_'", tag))
+				return
+			}
+		}
+	}
+}
+
+func validateListSort(verr *errors.ValidationError, sort repository.ListSort) {
+	switch sort.Field {
+	case "", repository.SortByCreatedAt, repository.SortByUpdatedAt, repository.SortByTitle:
+	default:
+		verr.Add("sort_by", errors.RuleAllowedValues, 0, fmt.Sprintf("unsupported sort field %q", sort.Field))
+	}
+}
+
+func validateLimit(verr *errors.ValidationError, limit int) {
+	if limit < 1 {
+		verr.Add("limit", errors.RuleMinValue, 1, "limit must be at least 1")
+	} else if limit > maxPageLimit {
+		verr.Add("limit", errors.RuleMaxValue, maxPageLimit,
+			fmt.Sprintf("limit exceeds %d", maxPageLimit))
+	}
 }
 
 func (s *NewsService) getCacheKey(slug string) string {
 	return fmt.Sprintf("news:%s", slug)
 }
 
-func (s *NewsService) getListCacheKey(page, limit int) string {
-	return fmt.Sprintf("news_list:%d:%d", page, limit)
+// listCacheNamespace - пространство имен кеша для страниц списка новостей
+const listCacheNamespace = "news_list"
+
+// getListCacheKey включает в ключ текущее поколение списков, поэтому ключ
+// нужно получать до запроса в БД
+func (s *NewsService) getListCacheKey(params ListParams) string {
+	generation := s.cache.Generation(listCacheNamespace)
+	position := fmt.Sprintf("%d", params.Page)
+	if params.PageToken != "" {
+		position = "token:" + params.PageToken
+	}
+
+	f := params.Filter
+	return fmt.Sprintf("%s:%d:%s:%d:%t:%s:%d:%d:%d:%d:%q:%q:%q:%q:%t",
+		listCacheNamespace, generation, position, params.Limit, params.SkipTotal, sortKey(params.Sort),
+		unixMicroOrZero(f.CreatedFrom), unixMicroOrZero(f.CreatedTo),
+		unixMicroOrZero(f.UpdatedFrom), unixMicroOrZero(f.UpdatedTo), f.TitlePrefix, f.Tags,
+		f.CreatedBy, f.UpdatedBy, f.IncludeUnpublished)
 }
 
-func (s *NewsService) invalidateListCache() {
-	// Простая реализация - можно улучшить, храня список ключей списков
-	// Для простоты очистим весь кеш списков (в реальном проекте лучше более точечную инвалидацию)
+func unixMicroOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixMicro()
 }
 
-type ListCacheItem struct {
-	News  []*domain.News
-	Total int64
+// invalidateListCache сбрасывает все закешированные страницы списка
+func (s *NewsService) invalidateListCache() {
+	s.cache.Invalidate(listCacheNamespace)
 }
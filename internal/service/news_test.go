@@ -0,0 +1,954 @@
+package service
+
+import (
+	"context"
+	stderrors "errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"news-service/internal/auth"
+	"news-service/internal/cache"
+	"news-service/internal/domain"
+	"news-service/internal/repository"
+	"news-service/pkg/diff"
+	"news-service/pkg/errors"
+)
+
+func TestValidateNewsData_CountsRunes(t *testing.T) {
+	s := &NewsService{}
+
+	// 500 кириллических символов занимают 1000 байт
+	title := strings.Repeat("я", maxTitleLength)
+	if err := s.validateNewsData("cyrillic-title", title, "content"); err != nil {
+		t.Fatalf("Expected title of %d runes to be valid, got %v", maxTitleLength, err)
+	}
+
+	err := s.validateNewsData("cyrillic-title", title+"я", "content")
+	if !stderrors.Is(err, errors.ErrInvalidTitle) {
+		t.Fatalf("Expected ErrInvalidTitle, got %v", err)
+	}
+}
+
+// hasViolation проверяет, что err - ValidationError с нарушением в поле field
+func hasViolation(err error, field string) bool {
+	var verr *errors.ValidationError
+	if !stderrors.As(err, &verr) {
+		return false
+	}
+	for _, v := range verr.Violations {
+		if v.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func TestValidateNewsData_CollectsAllViolations(t *testing.T) {
+	s := &NewsService{}
+
+	err := s.validateNewsData("Bad slug!", strings.Repeat("a", maxTitleLength+1), "")
+
+	var verr *errors.ValidationError
+	if !stderrors.As(err, &verr) {
+		t.Fatalf("Expected *ValidationError, got %T", err)
+	}
+
+	expected := map[string]string{
+		"slug":    errors.RulePattern,
+		"title":   errors.RuleMaxLength,
+		"content": errors.RuleRequired,
+	}
+	if len(verr.Violations) != len(expected) {
+		t.Fatalf("Expected %d violations, got %v", len(expected), verr.Violations)
+	}
+	for _, v := range verr.Violations {
+		if expected[v.Field] != v.Rule {
+			t.Errorf("Unexpected violation %+v", v)
+		}
+	}
+	if verr.Violations[1].Limit != maxTitleLength {
+		t.Errorf("Expected title limit %d, got %d", maxTitleLength, verr.Violations[1].Limit)
+	}
+}
+
+func newTestService(t *testing.T) (*NewsService, *fakeRepository) {
+	t.Helper()
+
+	repo := newFakeRepository()
+	c := cache.New(5 * time.Minute)
+	t.Cleanup(c.Stop)
+
+	return NewNewsService(repo, c), repo
+}
+
+func mustCreate(t *testing.T, s *NewsService, slug string) {
+	t.Helper()
+
+	if _, err := s.CreateNews(context.Background(), slug, "Title "+slug, "Content "+slug, Publication{}); err != nil {
+		t.Fatalf("Failed to create news %s: %v", slug, err)
+	}
+}
+
+func mustList(t *testing.T, s *NewsService) *ListResult {
+	t.Helper()
+
+	result, err := s.GetNewsList(context.Background(), ListParams{Page: 1, Limit: 10})
+	if err != nil {
+		t.Fatalf("Failed to get news list: %v", err)
+	}
+	return result
+}
+
+func listSlugs(result *ListResult) []string {
+	slugs := make([]string, len(result.News))
+	for i, news := range result.News {
+		slugs[i] = news.Slug
+	}
+	return slugs
+}
+
+func TestNewsService_ValidatesSlugOnEveryMethod(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	for _, slug := range []string{"", "bad slug", strings.Repeat("a", maxSlugLength+1)} {
+		calls := map[string]error{}
+		_, calls["GetNews"] = s.GetNews(ctx, slug, false)
+		calls["DeleteNews"] = s.DeleteNews(ctx, slug, 0)
+		_, calls["RestoreNews"] = s.RestoreNews(ctx, slug)
+
+		for method, err := range calls {
+			if !hasViolation(err, "slug") {
+				t.Errorf("%s(%q): expected slug violation, got %v", method, slug, err)
+			}
+		}
+	}
+}
+
+func TestNewsService_ListReflectsCreate(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "first")
+
+	// Первый запрос кладет страницу в кеш
+	if result := mustList(t, s); result.Total != 1 {
+		t.Fatalf("Expected total 1, got %d", result.Total)
+	}
+
+	mustCreate(t, s, "second")
+
+	result := mustList(t, s)
+	if result.Total != 2 {
+		t.Errorf("Expected total 2 after create, got %d", result.Total)
+	}
+	if slugs := listSlugs(result); len(slugs) != 2 || slugs[0] != "second" {
+		t.Errorf("Expected new news first in list, got %v", slugs)
+	}
+}
+
+func TestNewsService_ListReflectsUpdate(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "first")
+	mustList(t, s)
+
+	title := "Updated title"
+	if _, err := s.UpdateNews(context.Background(), "first", domain.NewsPatch{Title: &title}); err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+
+	result := mustList(t, s)
+	if len(result.News) != 1 || result.News[0].Title != "Updated title" {
+		t.Errorf("Expected updated title in list, got %+v", result.News)
+	}
+
+	news, err := s.GetNews(context.Background(), "first", false)
+	if err != nil {
+		t.Fatalf("Failed to get news: %v", err)
+	}
+	if news.Title != "Updated title" {
+		t.Errorf("Expected updated title, got %q", news.Title)
+	}
+}
+
+func TestNewsService_ListReflectsDelete(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "first")
+	mustCreate(t, s, "second")
+	mustList(t, s)
+
+	if err := s.DeleteNews(context.Background(), "second", 0); err != nil {
+		t.Fatalf("Failed to delete news: %v", err)
+	}
+
+	result := mustList(t, s)
+	if slugs := listSlugs(result); len(slugs) != 1 || slugs[0] != "first" {
+		t.Errorf("Expected only 'first' after delete, got %v", slugs)
+	}
+	if result.Total != 1 {
+		t.Errorf("Expected total 1 after delete, got %d", result.Total)
+	}
+}
+
+func TestNewsService_ListIsCachedBetweenWrites(t *testing.T) {
+	s, repo := newTestService(t)
+	mustCreate(t, s, "first")
+	mustList(t, s)
+
+	// Изменение в обход сервиса не должно быть видно: страница берется из кеша
+	repo.news["first"].Title = "Changed directly"
+
+	if result := mustList(t, s); result.News[0].Title != "Title first" {
+		t.Errorf("Expected cached title, got %q", result.News[0].Title)
+	}
+}
+
+func TestNewsService_UpdateNewsPatchesOnlyGivenFields(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "first")
+
+	title := "Новый заголовок"
+	news, err := s.UpdateNews(context.Background(), "first", domain.NewsPatch{Title: &title})
+	if err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+
+	if news.Title != title {
+		t.Errorf("Expected title %q, got %q", title, news.Title)
+	}
+	if news.Content != "Content first" {
+		t.Errorf("Expected content to be kept, got %q", news.Content)
+	}
+
+	if _, err := s.UpdateNews(context.Background(), "first", domain.NewsPatch{}); err == nil {
+		t.Error("Expected error for empty patch")
+	}
+}
+
+func TestNewsService_RejectsStaleVersion(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "first")
+
+	title := "Правка первого редактора"
+	news, err := s.UpdateNews(context.Background(), "first", domain.NewsPatch{Title: &title, ExpectedVersion: 1})
+	if err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+	if news.Version != 2 {
+		t.Fatalf("Expected version 2, got %d", news.Version)
+	}
+
+	// Второй редактор сохраняет изменения поверх устаревшей версии
+	title = "Правка второго редактора"
+	_, err = s.UpdateNews(context.Background(), "first", domain.NewsPatch{Title: &title, ExpectedVersion: 1})
+	if !stderrors.Is(err, errors.ErrConflict) {
+		t.Errorf("Expected ErrConflict on stale update, got %v", err)
+	}
+
+	if err := s.DeleteNews(context.Background(), "first", 1); !stderrors.Is(err, errors.ErrConflict) {
+		t.Errorf("Expected ErrConflict on stale delete, got %v", err)
+	}
+	if err := s.DeleteNews(context.Background(), "first", 2); err != nil {
+		t.Errorf("Expected delete with current version to succeed, got %v", err)
+	}
+}
+
+func TestNewsService_RenameNewsKeepsRedirect(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "tipo")
+
+	// Старый slug попадает в кеш до переименования
+	if _, err := s.GetNews(context.Background(), "tipo", false); err != nil {
+		t.Fatalf("Failed to get news: %v", err)
+	}
+
+	renamed, err := s.RenameNews(context.Background(), "tipo", "typo", 0)
+	if err != nil {
+		t.Fatalf("Failed to rename news: %v", err)
+	}
+	if renamed.Slug != "typo" || renamed.CreatedAt.IsZero() {
+		t.Fatalf("Unexpected renamed news: %+v", renamed)
+	}
+
+	news, err := s.GetNews(context.Background(), "tipo", false)
+	if err != nil {
+		t.Fatalf("Expected old slug to resolve, got %v", err)
+	}
+	if news.Slug != "typo" {
+		t.Errorf("Expected redirect to 'typo', got %q", news.Slug)
+	}
+
+	if _, err := s.CreateNews(context.Background(), "tipo", "Title", "Content", Publication{}); !stderrors.Is(err, errors.ErrDuplicateSlug) {
+		t.Errorf("Expected old slug to stay reserved, got %v", err)
+	}
+}
+
+func TestNewsService_WritesByOldSlugNameCanonicalSlug(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "tipo")
+	if _, err := s.RenameNews(context.Background(), "tipo", "typo", 0); err != nil {
+		t.Fatalf("Failed to rename news: %v", err)
+	}
+
+	ctx := context.Background()
+	title := "Changed"
+	writes := map[string]func() error{
+		"update": func() error {
+			_, err := s.UpdateNews(ctx, "tipo", domain.NewsPatch{Title: &title})
+			return err
+		},
+		"delete": func() error { return s.DeleteNews(ctx, "tipo", 0) },
+		"rename": func() error {
+			_, err := s.RenameNews(ctx, "tipo", "other", 0)
+			return err
+		},
+		"set tags": func() error {
+			_, err := s.SetNewsTags(ctx, "tipo", []string{"go"})
+			return err
+		},
+		"publish": func() error {
+			_, err := s.PublishNews(ctx, "tipo", time.Time{}, 0)
+			return err
+		},
+	}
+
+	for name, write := range writes {
+		var moved *errors.SlugMovedError
+		if err := write(); !stderrors.As(err, &moved) || moved.Slug != "typo" {
+			t.Errorf("%s: expected SlugMovedError naming 'typo', got %v", name, err)
+		}
+	}
+
+	news, err := s.GetNews(ctx, "typo", false)
+	if err != nil {
+		t.Fatalf("Failed to get news: %v", err)
+	}
+	if news.Title != "Title tipo" || news.Version != 2 || len(news.Tags) != 0 {
+		t.Errorf("Expected news to stay unchanged, got %+v", news)
+	}
+}
+
+func TestNewsService_CreateNewsGeneratesSlug(t *testing.T) {
+	s, _ := newTestService(t)
+
+	first, err := s.CreateNews(context.Background(), "", "Прогноз погоды", "Content", Publication{})
+	if err != nil {
+		t.Fatalf("Failed to create news: %v", err)
+	}
+	if first.Slug != "prognoz-pogody" {
+		t.Errorf("Expected generated slug 'prognoz-pogody', got %q", first.Slug)
+	}
+
+	second, err := s.CreateNews(context.Background(), "", "Прогноз погоды", "Content", Publication{})
+	if err != nil {
+		t.Fatalf("Failed to create news: %v", err)
+	}
+	if second.Slug != "prognoz-pogody-2" {
+		t.Errorf("Expected suffixed slug 'prognoz-pogody-2', got %q", second.Slug)
+	}
+}
+
+func TestNewsService_DeleteMovesNewsToTrash(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "first")
+	mustList(t, s)
+
+	if err := s.DeleteNews(context.Background(), "first", 0); err != nil {
+		t.Fatalf("Failed to delete news: %v", err)
+	}
+
+	if _, err := s.GetNews(context.Background(), "first", false); !stderrors.Is(err, errors.ErrNewsNotFound) {
+		t.Errorf("Expected deleted news to be hidden, got %v", err)
+	}
+
+	trash, total, err := s.ListDeletedNews(context.Background(), 1, 10)
+	if err != nil {
+		t.Fatalf("Failed to list deleted news: %v", err)
+	}
+	if total != 1 || len(trash) != 1 || trash[0].DeletedAt == nil {
+		t.Fatalf("Expected deleted news in trash, got %+v", trash)
+	}
+
+	if _, err := s.RestoreNews(context.Background(), "first"); err != nil {
+		t.Fatalf("Failed to restore news: %v", err)
+	}
+
+	// Восстановление сбрасывает кеш списков так же, как создание
+	if slugs := listSlugs(mustList(t, s)); len(slugs) != 1 || slugs[0] != "first" {
+		t.Errorf("Expected restored news in list, got %v", slugs)
+	}
+}
+
+func TestNewsService_PurgeDeletedNews(t *testing.T) {
+	s, repo := newTestService(t)
+	mustCreate(t, s, "first")
+
+	if err := s.DeleteNews(context.Background(), "first", 0); err != nil {
+		t.Fatalf("Failed to delete news: %v", err)
+	}
+
+	// Часы фейкового репозитория в прошлом, поэтому запись старше любого retention
+	purged, err := s.PurgeDeletedNews(context.Background(), time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to purge: %v", err)
+	}
+	if purged != 1 || len(repo.news) != 0 {
+		t.Errorf("Expected deleted news to be purged, got %d purged", purged)
+	}
+}
+
+func TestNewsService_ListSortedByTitleWithPageTokens(t *testing.T) {
+	s, _ := newTestService(t)
+	for _, slug := range []string{"c", "a", "d", "b", "e"} {
+		mustCreate(t, s, slug)
+	}
+
+	params := ListParams{
+		Limit:     2,
+		Page:      1,
+		SkipTotal: true,
+		Sort:      repository.ListSort{Field: repository.SortByTitle, Ascending: true},
+	}
+
+	var slugs []string
+	for {
+		result, err := s.GetNewsList(context.Background(), params)
+		if err != nil {
+			t.Fatalf("Failed to get news list: %v", err)
+		}
+		slugs = append(slugs, listSlugs(result)...)
+		if result.NextPageToken == "" {
+			break
+		}
+		params.PageToken = result.NextPageToken
+	}
+
+	if strings.Join(slugs, ",") != "a,b,c,d,e" {
+		t.Errorf("Expected news sorted by title, got %v", slugs)
+	}
+
+	// Токен, выданный для другой сортировки, отклоняется
+	params.Sort = repository.ListSort{}
+	if _, err := s.GetNewsList(context.Background(), params); !stderrors.Is(err, errors.ErrInvalidPagination) {
+		t.Errorf("Expected page token to be rejected for another sort order, got %v", err)
+	}
+}
+
+func TestNewsService_ListFiltersByTitlePrefix(t *testing.T) {
+	s, _ := newTestService(t)
+	for _, title := range []string{"Спорт: футбол", "спорт: хоккей", "Погода"} {
+		if _, err := s.CreateNews(context.Background(), "", title, "Content", Publication{}); err != nil {
+			t.Fatalf("Failed to create news: %v", err)
+		}
+	}
+
+	result, err := s.GetNewsList(context.Background(), ListParams{
+		Page:   1,
+		Limit:  10,
+		Filter: repository.ListFilter{TitlePrefix: "СПОРТ"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get news list: %v", err)
+	}
+	if result.Total != 2 || len(result.News) != 2 {
+		t.Errorf("Expected 2 news with title prefix, got %v", listSlugs(result))
+	}
+}
+
+func TestNewsService_SetNewsTagsNormalizesAndFilters(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "first")
+	mustCreate(t, s, "second")
+	mustCreate(t, s, "third")
+
+	// Прогреваем кеш списков и тегов
+	mustList(t, s)
+	if _, err := s.ListTags(context.Background()); err != nil {
+		t.Fatalf("Failed to list tags: %v", err)
+	}
+
+	news, err := s.SetNewsTags(context.Background(), "first", []string{" Спорт ", "спорт", "Футбол"})
+	if err != nil {
+		t.Fatalf("Failed to set tags: %v", err)
+	}
+	if want := []string{"спорт", "футбол"}; !slices.Equal(news.Tags, want) {
+		t.Errorf("Expected tags %v, got %v", want, news.Tags)
+	}
+	if _, err := s.SetNewsTags(context.Background(), "second", []string{"спорт"}); err != nil {
+		t.Fatalf("Failed to set tags: %v", err)
+	}
+
+	result, err := s.GetNewsList(context.Background(), ListParams{
+		Page:   1,
+		Limit:  10,
+		Filter: repository.ListFilter{Tags: []string{"ФУТБОЛ", "погода"}},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get news list: %v", err)
+	}
+	if slugs := listSlugs(result); !slices.Equal(slugs, []string{"first"}) {
+		t.Errorf("Expected only first news with tag, got %v", slugs)
+	}
+
+	tags, err := s.ListTags(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to list tags: %v", err)
+	}
+	want := []domain.TagCount{{Name: "спорт", Count: 2}, {Name: "футбол", Count: 1}}
+	if !slices.Equal(tags, want) {
+		t.Errorf("Expected tag counts %v, got %v", want, tags)
+	}
+
+	_, err = s.SetNewsTags(context.Background(), "third", []string{"bad/tag"})
+	if !hasViolation(err, "tags") {
+		t.Errorf("Expected tags violation, got %v", err)
+	}
+}
+
+func TestNewsService_DraftsAreHiddenUntilPublished(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "public")
+
+	draft, err := s.CreateNews(context.Background(), "draft", "Title", "Content", Publication{Status: domain.StatusDraft})
+	if err != nil {
+		t.Fatalf("Failed to create draft: %v", err)
+	}
+	if _, err := s.GetNews(context.Background(), "draft", false); !stderrors.Is(err, errors.ErrNewsNotFound) {
+		t.Errorf("Expected draft to be hidden, got %v", err)
+	}
+	if _, err := s.GetNews(context.Background(), "draft", true); err != nil {
+		t.Errorf("Expected draft to be visible with includeUnpublished, got %v", err)
+	}
+	if slugs := listSlugs(mustList(t, s)); !slices.Equal(slugs, []string{"public"}) {
+		t.Errorf("Expected only published news in list, got %v", slugs)
+	}
+
+	published, err := s.PublishNews(context.Background(), "draft", time.Time{}, draft.Version)
+	if err != nil {
+		t.Fatalf("Failed to publish news: %v", err)
+	}
+	if published.Status != domain.StatusPublished {
+		t.Errorf("Expected status %q, got %q", domain.StatusPublished, published.Status)
+	}
+	if slugs := listSlugs(mustList(t, s)); len(slugs) != 2 {
+		t.Errorf("Expected published news in list, got %v", slugs)
+	}
+
+	if _, err := s.UnpublishNews(context.Background(), "draft", true, 0); err != nil {
+		t.Fatalf("Failed to archive news: %v", err)
+	}
+	if _, err := s.GetNews(context.Background(), "draft", false); !stderrors.Is(err, errors.ErrNewsNotFound) {
+		t.Errorf("Expected archived news to be hidden, got %v", err)
+	}
+}
+
+func TestNewsService_SchedulerPublishesDueNews(t *testing.T) {
+	s, repo := newTestService(t)
+
+	news, err := s.CreateNews(context.Background(), "later", "Title", "Content",
+		Publication{PublishAt: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatalf("Failed to create news: %v", err)
+	}
+	if news.Status != domain.StatusScheduled {
+		t.Fatalf("Expected status %q, got %q", domain.StatusScheduled, news.Status)
+	}
+
+	// Прогреваем кеш: отложенная новость еще не видна
+	if result := mustList(t, s); len(result.News) != 0 {
+		t.Fatalf("Expected empty list, got %v", listSlugs(result))
+	}
+
+	// Время публикации наступило
+	past := time.Now().Add(-time.Minute)
+	repo.mu.Lock()
+	repo.news["later"].PublishAt = &past
+	repo.mu.Unlock()
+
+	published, err := s.PublishScheduledNews(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to publish scheduled news: %v", err)
+	}
+	if published != 1 {
+		t.Errorf("Expected 1 published news, got %d", published)
+	}
+	if slugs := listSlugs(mustList(t, s)); !slices.Equal(slugs, []string{"later"}) {
+		t.Errorf("Expected scheduled news in list after publishing, got %v", slugs)
+	}
+
+	_, err = s.CreateNews(context.Background(), "bad", "Title", "Content",
+		Publication{Status: domain.StatusScheduled})
+	if !hasViolation(err, "publish_at") {
+		t.Errorf("Expected publish_at violation for scheduled news without publish_at, got %v", err)
+	}
+}
+
+func TestNewsService_RevisionsRecordEditsAndRollback(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := auth.WithIdentity(context.Background(), auth.Identity{Subject: "editor"})
+
+	if _, err := s.CreateNews(ctx, "story", "Title", "first line\nsecond line", Publication{}); err != nil {
+		t.Fatalf("Failed to create news: %v", err)
+	}
+	content := "first line\nchanged line"
+	if _, err := s.UpdateNews(ctx, "story", domain.NewsPatch{Content: &content}); err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+
+	revisions, total, err := s.ListRevisions(context.Background(), "story", 1, 10)
+	if err != nil {
+		t.Fatalf("Failed to list revisions: %v", err)
+	}
+	if total != 2 || revisions[0].Version != 2 || revisions[1].Version != 1 {
+		t.Fatalf("Expected revisions 2 and 1, got %d revisions", total)
+	}
+	if revisions[0].Author != "editor" {
+		t.Errorf("Expected author %q, got %q", "editor", revisions[0].Author)
+	}
+
+	d, err := s.DiffRevisions(context.Background(), "story", 1, 2)
+	if err != nil {
+		t.Fatalf("Failed to diff revisions: %v", err)
+	}
+	want := []diff.Line{
+		{Op: diff.Equal, Text: "first line"},
+		{Op: diff.Delete, Text: "second line"},
+		{Op: diff.Insert, Text: "changed line"},
+	}
+	if !slices.Equal(d.Content, want) {
+		t.Errorf("Expected content diff %v, got %v", want, d.Content)
+	}
+
+	news, err := s.RollbackNews(ctx, "story", 1, 2)
+	if err != nil {
+		t.Fatalf("Failed to roll back news: %v", err)
+	}
+	if news.Content != "first line\nsecond line" || news.Version != 3 {
+		t.Errorf("Expected content of revision 1 in version 3, got %q in version %d", news.Content, news.Version)
+	}
+	if _, err := s.GetRevision(context.Background(), "story", 3); err != nil {
+		t.Errorf("Expected rollback to create revision 3, got %v", err)
+	}
+	if _, err := s.GetRevision(context.Background(), "story", 42); !stderrors.Is(err, errors.ErrRevisionNotFound) {
+		t.Errorf("Expected ErrRevisionNotFound, got %v", err)
+	}
+}
+
+func TestNewsService_RejectsOversizedContent(t *testing.T) {
+	s, _ := newTestService(t)
+
+	content := strings.Repeat("я", maxContentLength+1)
+	_, err := s.CreateNews(context.Background(), "big", "Title", content, Publication{})
+	if !hasViolation(err, "content") {
+		t.Errorf("Expected content violation, got %v", err)
+	}
+
+	if _, err := s.CreateNews(context.Background(), "big", "Title", content[:maxContentLength*2], Publication{}); err != nil {
+		t.Errorf("Expected content of %d characters to be accepted, got %v", maxContentLength, err)
+	}
+}
+
+func TestNewsService_DiffRejectsTooManyLines(t *testing.T) {
+	s, _ := newTestService(t)
+	mustCreate(t, s, "story")
+
+	content := strings.Repeat("line\n", maxDiffLines+1)
+	if _, err := s.UpdateNews(context.Background(), "story", domain.NewsPatch{Content: &content}); err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+
+	_, err := s.DiffRevisions(context.Background(), "story", 1, 2)
+	if !hasViolation(err, "to_version") {
+		t.Errorf("Expected to_version violation for a revision over %d lines, got %v", maxDiffLines, err)
+	}
+}
+
+func TestNewsService_EveryVersionHasRevision(t *testing.T) {
+	s, repo := newTestService(t)
+	ctx := auth.WithIdentity(context.Background(), auth.Identity{Subject: "editor"})
+
+	if _, err := s.CreateNews(ctx, "story", "Title", "Content", Publication{}); err != nil {
+		t.Fatalf("Failed to create news: %v", err)
+	}
+
+	steps := []struct {
+		name  string
+		apply func() error
+	}{
+		{"rename", func() error {
+			_, err := s.RenameNews(ctx, "story", "tale", 0)
+			return err
+		}},
+		{"unpublish", func() error {
+			_, err := s.UnpublishNews(ctx, "tale", false, 0)
+			return err
+		}},
+		{"publish", func() error {
+			_, err := s.PublishNews(ctx, "tale", time.Time{}, 0)
+			return err
+		}},
+		{"delete", func() error { return s.DeleteNews(ctx, "tale", 0) }},
+		{"restore", func() error {
+			_, err := s.RestoreNews(ctx, "tale")
+			return err
+		}},
+		{"schedule", func() error {
+			_, err := s.PublishNews(ctx, "tale", time.Now().Add(time.Hour), 0)
+			return err
+		}},
+		{"publish due", func() error {
+			// Время публикации наступило
+			past := time.Now().Add(-time.Minute)
+			repo.news["tale"].PublishAt = &past
+			_, err := s.PublishScheduledNews(context.Background())
+			return err
+		}},
+	}
+
+	for i, step := range steps {
+		if err := step.apply(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		version := int64(i + 2)
+		if got := repo.news["tale"].Version; got != version {
+			t.Fatalf("%s: expected version %d, got %d", step.name, version, got)
+		}
+		// Ревизии удаленной новости не видны до восстановления
+		if step.name == "delete" {
+			continue
+		}
+		if _, err := s.GetRevision(context.Background(), "tale", version); err != nil {
+			t.Errorf("%s: expected revision %d, got %v", step.name, version, err)
+		}
+	}
+
+	revisions, total, err := s.ListRevisions(context.Background(), "tale", 1, 20)
+	if err != nil {
+		t.Fatalf("Failed to list revisions: %v", err)
+	}
+	if total != int64(len(steps)+1) {
+		t.Fatalf("Expected a revision for each of %d versions, got %d", len(steps)+1, total)
+	}
+	for _, revision := range revisions {
+		if revision.Author != "editor" {
+			t.Errorf("Expected revision %d by %q, got %q", revision.Version, "editor", revision.Author)
+		}
+	}
+}
+
+func TestNewsService_RecordsAuthorsAndFiltersByThem(t *testing.T) {
+	s, _ := newTestService(t)
+	alice := auth.WithIdentity(context.Background(), auth.Identity{Subject: "alice", Role: auth.RoleEditor})
+	bob := auth.WithIdentity(context.Background(), auth.Identity{Subject: "bob", Role: auth.RoleEditor})
+
+	if _, err := s.CreateNews(alice, "by-alice", "Title", "Content", Publication{}); err != nil {
+		t.Fatalf("Failed to create news: %v", err)
+	}
+	if _, err := s.CreateNews(bob, "by-bob", "Title", "Content", Publication{}); err != nil {
+		t.Fatalf("Failed to create news: %v", err)
+	}
+
+	title := "Edited by bob"
+	news, err := s.UpdateNews(bob, "by-alice", domain.NewsPatch{Title: &title})
+	if err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+	if news.CreatedBy != "alice" || news.UpdatedBy != "bob" {
+		t.Errorf("Expected created_by alice and updated_by bob, got %q and %q", news.CreatedBy, news.UpdatedBy)
+	}
+
+	result, err := s.GetNewsList(context.Background(), ListParams{
+		Page:   1,
+		Limit:  10,
+		Filter: repository.ListFilter{CreatedBy: "alice"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get news list: %v", err)
+	}
+	if slugs := listSlugs(result); !slices.Equal(slugs, []string{"by-alice"}) {
+		t.Errorf("Expected only alice's news, got %v", slugs)
+	}
+
+	result, err = s.GetNewsList(context.Background(), ListParams{
+		Page:   1,
+		Limit:  10,
+		Filter: repository.ListFilter{UpdatedBy: "bob"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to get news list: %v", err)
+	}
+	if len(result.News) != 2 {
+		t.Errorf("Expected both news last updated by bob, got %v", listSlugs(result))
+	}
+}
+
+// laggingReplica имитирует реплику, которая еще не получила записи: пока
+// задан снимок stale, чтения возвращают его и отмечаются как возможно устаревшие
+type laggingReplica struct {
+	*fakeRepository
+	stale map[string]domain.News
+}
+
+func (r *laggingReplica) GetBySlug(ctx context.Context, slug string) (*domain.News, error) {
+	if r.stale == nil {
+		return r.fakeRepository.GetBySlug(ctx, slug)
+	}
+	repository.MarkMayBeStale(ctx)
+	news, exists := r.stale[slug]
+	if !exists {
+		return nil, errors.ErrNewsNotFound
+	}
+	return &news, nil
+}
+
+func (r *laggingReplica) GetList(ctx context.Context, opts repository.ListOptions) ([]*domain.News, int64, error) {
+	if r.stale == nil {
+		return r.fakeRepository.GetList(ctx, opts)
+	}
+	repository.MarkMayBeStale(ctx)
+	var list []*domain.News
+	for _, news := range r.stale {
+		list = append(list, &news)
+	}
+	return list, int64(len(list)), nil
+}
+
+func TestNewsService_DoesNotCacheLaggedReplicaReads(t *testing.T) {
+	repo := &laggingReplica{fakeRepository: newFakeRepository()}
+	c := cache.New(5 * time.Minute)
+	t.Cleanup(c.Stop)
+	s := NewNewsService(repo, c)
+	ctx := context.Background()
+
+	mustCreate(t, s, "first")
+	repo.stale = map[string]domain.News{"first": *repo.news["first"]}
+
+	title := "Updated title"
+	if _, err := s.UpdateNews(ctx, "first", domain.NewsPatch{Title: &title}); err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+
+	// Реплика еще не получила обновление: клиент видит старые данные
+	news, err := s.GetNews(ctx, "first", false)
+	if err != nil {
+		t.Fatalf("Failed to get news: %v", err)
+	}
+	if news.Title != "Title first" {
+		t.Fatalf("Expected lagged title from replica, got %q", news.Title)
+	}
+	mustList(t, s)
+
+	// Реплика догнала primary: устаревший ответ не должен остаться в кеше
+	repo.stale = nil
+
+	news, err = s.GetNews(ctx, "first", false)
+	if err != nil {
+		t.Fatalf("Failed to get news: %v", err)
+	}
+	if news.Title != title {
+		t.Errorf("Expected %q after replica caught up, got cached %q", title, news.Title)
+	}
+	if result := mustList(t, s); len(result.News) != 1 || result.News[0].Title != title {
+		t.Errorf("Expected %q in list after replica caught up, got %+v", title, result.News)
+	}
+
+	// Свежий ответ кешируется как обычно
+	repo.news["first"].Title = "Changed directly"
+	if news, _ := s.GetNews(ctx, "first", false); news.Title != title {
+		t.Errorf("Expected fresh read to be cached, got %q", news.Title)
+	}
+}
+
+func TestNewsService_SearchValidatesQueryAndPagination(t *testing.T) {
+	s, _ := newTestService(t)
+
+	tests := []struct {
+		name        string
+		query       string
+		page, limit int
+		fields      []string
+	}{
+		{"empty query", "", 1, 10, []string{"query"}},
+		{"blank query", "   ", 1, 10, []string{"query"}},
+		{"long query", strings.Repeat("я", maxSearchQueryLength+1), 1, 10, []string{"query"}},
+		{"zero page", "go", 0, 10, []string{"page"}},
+		{"zero limit", "go", 1, 0, []string{"limit"}},
+		{"limit above max", "go", 1, maxPageLimit + 1, []string{"limit"}},
+		{"all at once", "", 0, 0, []string{"query", "page", "limit"}},
+	}
+
+	for _, tt := range tests {
+		_, _, err := s.SearchNews(context.Background(), tt.query, tt.page, tt.limit)
+		var verr *errors.ValidationError
+		if !stderrors.As(err, &verr) {
+			t.Errorf("%s: expected ValidationError, got %v", tt.name, err)
+			continue
+		}
+		for _, field := range tt.fields {
+			if !hasViolation(err, field) {
+				t.Errorf("%s: expected %s violation, got %v", tt.name, field, err)
+			}
+		}
+		if len(verr.Violations) != len(tt.fields) {
+			t.Errorf("%s: expected %d violations, got %v", tt.name, len(tt.fields), verr.Violations)
+		}
+	}
+}
+
+func TestNewsService_SearchPaginates(t *testing.T) {
+	s, _ := newTestService(t)
+	for _, slug := range []string{"first", "second", "third"} {
+		mustCreate(t, s, slug)
+	}
+	mustCreate(t, s, "unrelated")
+	other := "Другой заголовок"
+	content := "Другое содержимое"
+	if _, err := s.UpdateNews(context.Background(), "unrelated", domain.NewsPatch{Title: &other, Content: &content}); err != nil {
+		t.Fatalf("Failed to update news: %v", err)
+	}
+
+	var slugs []string
+	for page := 1; page <= 3; page++ {
+		hits, total, err := s.SearchNews(context.Background(), "  title ", page, 2)
+		if err != nil {
+			t.Fatalf("Failed to search news: %v", err)
+		}
+		if total != 3 {
+			t.Errorf("Page %d: expected total 3, got %d", page, total)
+		}
+		for _, hit := range hits {
+			slugs = append(slugs, hit.News.Slug)
+		}
+	}
+
+	// Страницы не пересекаются и вместе дают все найденные новости
+	if !slices.Equal(slugs, []string{"third", "second", "first"}) {
+		t.Errorf("Expected each match exactly once across pages, got %v", slugs)
+	}
+}
+
+func TestNewsService_SearchReturnsOnlyPublished(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	mustCreate(t, s, "public")
+	if _, err := s.CreateNews(ctx, "draft", "Title draft", "Content", Publication{Status: domain.StatusDraft}); err != nil {
+		t.Fatalf("Failed to create draft: %v", err)
+	}
+	scheduled := Publication{Status: domain.StatusScheduled, PublishAt: time.Now().Add(time.Hour)}
+	if _, err := s.CreateNews(ctx, "scheduled", "Title scheduled", "Content", scheduled); err != nil {
+		t.Fatalf("Failed to create scheduled news: %v", err)
+	}
+	mustCreate(t, s, "deleted")
+	if err := s.DeleteNews(ctx, "deleted", 0); err != nil {
+		t.Fatalf("Failed to delete news: %v", err)
+	}
+
+	hits, total, err := s.SearchNews(ctx, "title", 1, 10)
+	if err != nil {
+		t.Fatalf("Failed to search news: %v", err)
+	}
+	if total != 1 || len(hits) != 1 || hits[0].News.Slug != "public" {
+		t.Errorf("Expected only the published news, got %d hits of %d", len(hits), total)
+	}
+}
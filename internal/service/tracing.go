@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("news-service/internal/service")
+
+// cacheGet читает кеш в отдельном span, чтобы в трассировке было видно,
+// ответил ли кеш или запрос ушел в БД
+func (s *NewsService) cacheGet(ctx context.Context, key string) (interface{}, bool) {
+	_, span := tracer.Start(ctx, "cache.Get", trace.WithAttributes(attribute.String("cache.key", key)))
+	defer span.End()
+
+	value, exists := s.cache.Get(key)
+	span.SetAttributes(attribute.Bool("cache.hit", exists))
+	return value, exists
+}
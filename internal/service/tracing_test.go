@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"news-service/internal/tracing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestNewsService_TracesCacheLookups(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := tracing.NewProvider(tracing.Config{SampleRatio: 1}, sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { provider.Shutdown(context.Background()) })
+
+	s, _ := newTestService(t)
+	mustCreate(t, s, "traced-news")
+	exporter.Reset()
+
+	ctx, root := provider.Tracer("test").Start(context.Background(), "request")
+	if _, err := s.GetNews(ctx, "traced-news", false); err != nil {
+		t.Fatalf("Failed to get news: %v", err)
+	}
+	root.End()
+
+	spans := exporter.GetSpans()
+	byName := make(map[string]tracetest.SpanStub, len(spans))
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+
+	getNews, ok := byName["NewsService.GetNews"]
+	if !ok {
+		t.Fatalf("Expected NewsService.GetNews span, got %v", spans)
+	}
+	if getNews.Parent.SpanID() != root.SpanContext().SpanID() {
+		t.Error("Expected service span to be a child of the request span")
+	}
+
+	lookup, ok := byName["cache.Get"]
+	if !ok {
+		t.Fatalf("Expected cache.Get span, got %v", spans)
+	}
+	if lookup.Parent.SpanID() != getNews.SpanContext.SpanID() {
+		t.Error("Expected cache lookup to be a child of the service span")
+	}
+	if !hasAttribute(lookup.Attributes, attribute.Bool("cache.hit", true)) {
+		t.Errorf("Expected cache hit to be recorded, got %v", lookup.Attributes)
+	}
+}
+
+func hasAttribute(attrs []attribute.KeyValue, want attribute.KeyValue) bool {
+	for _, attr := range attrs {
+		if attr == want {
+			return true
+		}
+	}
+	return false
+}
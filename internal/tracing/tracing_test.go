@@ -0,0 +1,20 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSetup_Exporters(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Config{Exporter: ExporterNone})
+	if err != nil {
+		t.Fatalf("Expected disabled tracing to be set up, got %v", err)
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("Expected no-op shutdown, got %v", err)
+	}
+
+	if _, err := Setup(context.Background(), Config{Exporter: "jaeger"}); err == nil {
+		t.Error("Expected unknown exporter to be rejected")
+	}
+}
@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Server - служебный HTTP-сервер для метрик и проверок состояния. Работает
+// на отдельном порту, чтобы не публиковать служебные пути вместе с API.
+type Server struct {
+	mux        *http.ServeMux
+	httpServer *http.Server
+}
+
+// NewServer создает сервер на address. http.Server создается сразу, чтобы
+// Stop, вызванный из другой горутины, не гонялся со Start.
+func NewServer(address string) *Server {
+	mux := http.NewServeMux()
+	return &Server{
+		mux: mux,
+		httpServer: &http.Server{
+			Addr:              address,
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// Handle регистрирует обработчик; вызывается до Start
+func (s *Server) Handle(pattern string, handler http.Handler) {
+	s.mux.Handle(pattern, handler)
+}
+
+// Start принимает запросы до вызова Stop; после Stop сразу возвращает nil
+func (s *Server) Start() error {
+	if err := s.httpServer.ListenAndServe(); err != nil && !stderrors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve admin HTTP on %s: %w", s.httpServer.Addr, err)
+	}
+	return nil
+}
+
+func (s *Server) Stop(ctx context.Context) error {
+	return s.httpServer.Shutdown(ctx)
+}
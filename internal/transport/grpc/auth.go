@@ -0,0 +1,101 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"news-service/internal/auth"
+	"news-service/pkg/errors"
+	pb "news-service/proto/news"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// publicAccess - метод доступен без токена
+const publicAccess auth.Role = ""
+
+// methodRoles - минимальная роль для каждого метода. Методы NewsService,
+// которых нет в таблице, запрещены: новый метод нужно явно внести в политику.
+var methodRoles = map[string]auth.Role{
+	pb.NewsService_GetNews_FullMethodName:     publicAccess,
+	pb.NewsService_GetNewsList_FullMethodName: publicAccess,
+	pb.NewsService_SearchNews_FullMethodName:  publicAccess,
+	pb.NewsService_ListTags_FullMethodName:    publicAccess,
+
+	// Ревизии содержат полный текст черновиков и отложенных новостей,
+	// поэтому доступны тем же ролям, что и include_unpublished
+	pb.NewsService_ListNewsRevisions_FullMethodName: unpublishedRole,
+	pb.NewsService_GetNewsRevision_FullMethodName:   unpublishedRole,
+	pb.NewsService_DiffNewsRevisions_FullMethodName: unpublishedRole,
+
+	pb.NewsService_CreateNews_FullMethodName:      auth.RoleEditor,
+	pb.NewsService_UpdateNews_FullMethodName:      auth.RoleEditor,
+	pb.NewsService_RenameNews_FullMethodName:      auth.RoleEditor,
+	pb.NewsService_SetNewsTags_FullMethodName:     auth.RoleEditor,
+	pb.NewsService_PublishNews_FullMethodName:     auth.RoleEditor,
+	pb.NewsService_UnpublishNews_FullMethodName:   auth.RoleEditor,
+	pb.NewsService_RollbackNews_FullMethodName:    auth.RoleEditor,
+	pb.NewsService_RestoreNews_FullMethodName:     auth.RoleEditor,
+	pb.NewsService_ListDeletedNews_FullMethodName: auth.RoleEditor,
+
+	pb.NewsService_DeleteNews_FullMethodName: auth.RoleAdmin,
+}
+
+// unpublishedRole - роль, нужная для include_unpublished в запросах чтения
+const unpublishedRole = auth.RoleEditor
+
+// unpublishedRequest - запрос, который может попросить неопубликованные новости
+type unpublishedRequest interface {
+	GetIncludeUnpublished() bool
+}
+
+// authInterceptor проверяет bearer-токен и роль, нужную методу, и кладет
+// пользователя в контекст. Без верификатора проверка отключена.
+func (s *Server) authInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if s.verifier == nil {
+		return handler(ctx, req)
+	}
+
+	required, known := methodRoles[info.FullMethod]
+	if !known && strings.HasPrefix(info.FullMethod, "/"+pb.NewsService_ServiceDesc.ServiceName+"/") {
+		return nil, s.statusError(ctx, errors.ErrPermissionDenied)
+	}
+	if r, ok := req.(unpublishedRequest); ok && r.GetIncludeUnpublished() {
+		required = unpublishedRole
+	}
+
+	token, hasToken := bearerToken(ctx)
+	if !hasToken {
+		if required != publicAccess {
+			return nil, s.statusError(ctx, errors.ErrUnauthenticated)
+		}
+		return handler(ctx, req)
+	}
+
+	// Переданный токен проверяется и для публичных методов
+	identity, err := s.verifier.Verify(token)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+	if !identity.Role.Allows(required) {
+		return nil, s.statusError(ctx, errors.ErrPermissionDenied)
+	}
+
+	return handler(auth.WithIdentity(ctx, identity), req)
+}
+
+// bearerToken извлекает токен из заголовка authorization: Bearer <token>
+func bearerToken(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	for _, value := range md.Get("authorization") {
+		scheme, token, found := strings.Cut(value, " ")
+		if found && strings.EqualFold(scheme, "bearer") && token != "" {
+			return token, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"news-service/internal/auth"
+	pb "news-service/proto/news"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "test-secret"
+
+// withToken кладет токен пользователя с ролью role в метаданные запроса;
+// при пустой role claim не передается
+func withToken(t *testing.T, role string) context.Context {
+	t.Helper()
+
+	claims := jwt.MapClaims{"sub": "alice", "exp": time.Now().Add(time.Hour).Unix()}
+	if role != "" {
+		claims["role"] = role
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	verifier, err := auth.NewVerifier(auth.VerifierConfig{HS256Secret: testSecret})
+	if err != nil {
+		t.Fatalf("Failed to create verifier: %v", err)
+	}
+	s := newTestServer(WithAuth(verifier))
+
+	badToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer not-a-jwt"))
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		method   string
+		req      interface{}
+		wantCode codes.Code
+		wantRole auth.Role
+	}{
+		{"public without token", context.Background(), pb.NewsService_GetNews_FullMethodName, &pb.GetNewsRequest{}, codes.OK, ""},
+		{"missing token", context.Background(), pb.NewsService_CreateNews_FullMethodName, &pb.CreateNewsRequest{}, codes.Unauthenticated, ""},
+		{"bad token", badToken, pb.NewsService_CreateNews_FullMethodName, &pb.CreateNewsRequest{}, codes.Unauthenticated, ""},
+		{"bad token on public method", badToken, pb.NewsService_GetNews_FullMethodName, &pb.GetNewsRequest{}, codes.Unauthenticated, ""},
+		{"insufficient role", withToken(t, "editor"), pb.NewsService_DeleteNews_FullMethodName, &pb.DeleteNewsRequest{}, codes.PermissionDenied, ""},
+		{"editor allowed", withToken(t, "editor"), pb.NewsService_CreateNews_FullMethodName, &pb.CreateNewsRequest{}, codes.OK, auth.RoleEditor},
+		{"reader with include_unpublished", withToken(t, "reader"), pb.NewsService_GetNewsList_FullMethodName, &pb.GetNewsListRequest{IncludeUnpublished: true}, codes.PermissionDenied, ""},
+		{"anonymous with include_unpublished", context.Background(), pb.NewsService_GetNews_FullMethodName, &pb.GetNewsRequest{IncludeUnpublished: true}, codes.Unauthenticated, ""},
+		{"editor with include_unpublished", withToken(t, "editor"), pb.NewsService_GetNewsList_FullMethodName, &pb.GetNewsListRequest{IncludeUnpublished: true}, codes.OK, auth.RoleEditor},
+		{"role-less token on revisions", withToken(t, ""), pb.NewsService_ListNewsRevisions_FullMethodName, &pb.ListNewsRevisionsRequest{}, codes.PermissionDenied, ""},
+		{"reader on revision", withToken(t, "reader"), pb.NewsService_GetNewsRevision_FullMethodName, &pb.GetNewsRevisionRequest{}, codes.PermissionDenied, ""},
+		{"reader on diff", withToken(t, "reader"), pb.NewsService_DiffNewsRevisions_FullMethodName, &pb.DiffNewsRevisionsRequest{}, codes.PermissionDenied, ""},
+		{"editor on revisions", withToken(t, "editor"), pb.NewsService_DiffNewsRevisions_FullMethodName, &pb.DiffNewsRevisionsRequest{}, codes.OK, auth.RoleEditor},
+		{"method outside policy", withToken(t, "admin"), "/news.NewsService/Unknown", &pb.GetNewsRequest{}, codes.PermissionDenied, ""},
+	}
+
+	for _, tt := range tests {
+		called := false
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			called = true
+			identity, ok := auth.FromContext(ctx)
+			if tt.wantRole != "" && (!ok || identity.Role != tt.wantRole || identity.Subject != "alice") {
+				t.Errorf("%s: expected identity with role %s, got %+v", tt.name, tt.wantRole, identity)
+			}
+			return nil, nil
+		}
+
+		_, err := s.authInterceptor(tt.ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+		if code := status.Code(err); code != tt.wantCode {
+			t.Errorf("%s: expected %s, got %v", tt.name, tt.wantCode, err)
+		}
+		if called != (tt.wantCode == codes.OK) {
+			t.Errorf("%s: expected handler called=%t, got %t", tt.name, tt.wantCode == codes.OK, called)
+		}
+	}
+}
+
+func TestAuthInterceptor_DisabledWithoutVerifier(t *testing.T) {
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: pb.NewsService_DeleteNews_FullMethodName}
+	if _, err := newTestServer().authInterceptor(context.Background(), &pb.DeleteNewsRequest{}, info, handler); err != nil || !called {
+		t.Errorf("Expected request to pass without verifier, got %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+
+	"news-service/internal/auth"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// forwardedForHeader - адрес клиента, который передает прокси (HTTP-шлюз)
+	forwardedForHeader = "x-forwarded-for"
+	// proxyKeyHeader - ключ, которым прокси подтверждает право передавать адрес клиента
+	proxyKeyHeader = "x-proxy-key"
+)
+
+// WithProxyKey разрешает прокси, передающим этот ключ в x-proxy-key, указывать
+// адрес клиента в x-forwarded-for. Без ключа заголовок игнорируется: иначе
+// любой клиент мог бы выдать себя за другой адрес и обойти ограничение частоты.
+func WithProxyKey(key string) Option {
+	return func(s *Server) {
+		s.proxyKey = key
+	}
+}
+
+type clientAddrKey struct{}
+
+// clientAddrInterceptor определяет адрес клиента и кладет его в контекст для
+// ClientKey. Адрес из x-forwarded-for принимается только вместе с верным
+// ключом прокси, в остальных случаях используется адрес соединения.
+func (s *Server) clientAddrInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if addr := s.clientAddr(ctx); addr != "" {
+		ctx = context.WithValue(ctx, clientAddrKey{}, addr)
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) clientAddr(ctx context.Context) string {
+	if s.trustedProxy(ctx) {
+		if forwarded := metadata.ValueFromIncomingContext(ctx, forwardedForHeader); len(forwarded) > 0 {
+			// Первый адрес в списке - исходный клиент
+			client, _, _ := strings.Cut(forwarded[0], ",")
+			if client = strings.TrimSpace(client); client != "" {
+				return client
+			}
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	return addr
+}
+
+func (s *Server) trustedProxy(ctx context.Context) bool {
+	if s.proxyKey == "" {
+		return false
+	}
+	keys := metadata.ValueFromIncomingContext(ctx, proxyKeyHeader)
+	return len(keys) == 1 && subtle.ConstantTimeCompare([]byte(keys[0]), []byte(s.proxyKey)) == 1
+}
+
+// ClientKey определяет клиента: пользователь из токена, иначе IP-адрес,
+// найденный clientAddrInterceptor. Репозиторий использует тот же ключ,
+// чтобы после записи направлять чтения клиента в primary.
+func ClientKey(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return "user:" + identity.Subject
+	}
+	if addr, ok := ctx.Value(clientAddrKey{}).(string); ok {
+		return "ip:" + addr
+	}
+	return unknownClient
+}
+
+// unknownClient - ключ клиента без пользователя и адреса
+const unknownClient = "unknown"
+
+// CallerKey - ClientKey для закрепления чтений за primary после записи.
+// Для неизвестного клиента возвращает пустую строку: иначе фоновые задачи и
+// все клиенты без адреса делили бы одно закрепление.
+func CallerKey(ctx context.Context) string {
+	if key := ClientKey(ctx); key != unknownClient {
+		return key
+	}
+	return ""
+}
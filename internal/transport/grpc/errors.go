@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	"news-service/pkg/errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorDomain передается в google.rpc.ErrorInfo.domain
+const errorDomain = "news-service"
+
+// errorMapping описывает, как доменная ошибка отображается в статус gRPC
+type errorMapping struct {
+	err     error
+	code    codes.Code
+	reason  string
+	message string
+	// field заполняется для ошибок валидации и попадает в google.rpc.BadRequest
+	field string
+}
+
+var errorMappings = []errorMapping{
+	{err: errors.ErrNewsNotFound, code: codes.NotFound, reason: "NEWS_NOT_FOUND", message: "News not found"},
+	{err: errors.ErrRevisionNotFound, code: codes.NotFound, reason: "REVISION_NOT_FOUND", message: "Revision not found"},
+	{err: errors.ErrUnauthenticated, code: codes.Unauthenticated, reason: "UNAUTHENTICATED", message: "Authentication required"},
+	{err: errors.ErrPermissionDenied, code: codes.PermissionDenied, reason: "PERMISSION_DENIED", message: "Permission denied"},
+	{err: errors.ErrRateLimited, code: codes.ResourceExhausted, reason: "RATE_LIMITED", message: "Too many requests, retry later"},
+	{err: errors.ErrDuplicateSlug, code: codes.AlreadyExists, reason: "DUPLICATE_SLUG", message: "News with this slug already exists"},
+	{err: errors.ErrConflict, code: codes.Aborted, reason: "VERSION_CONFLICT", message: "News was modified by another request"},
+	{err: errors.ErrInvalidSlug, code: codes.InvalidArgument, reason: "INVALID_ARGUMENT", message: "Invalid slug format", field: "slug"},
+	{err: errors.ErrInvalidTitle, code: codes.InvalidArgument, reason: "INVALID_ARGUMENT", message: "Invalid title", field: "title"},
+	{err: errors.ErrInvalidContent, code: codes.InvalidArgument, reason: "INVALID_ARGUMENT", message: "Invalid content", field: "content"},
+	{err: errors.ErrInvalidPagination, code: codes.InvalidArgument, reason: "INVALID_ARGUMENT", message: "Invalid pagination parameters", field: "page"},
+}
+
+var internalErrorMapping = errorMapping{code: codes.Internal, reason: "INTERNAL", message: "Internal server error"}
+
+// statusErrorsHeader - заголовок, которым клиент просит статусы gRPC даже в
+// режиме совместимости. Его передает HTTP-шлюз: клиенты REST получают код
+// HTTP по коду gRPC, а не 200 с текстом ошибки.
+const statusErrorsHeader = "x-status-errors"
+
+// handleError превращает ошибку сервиса в ответ клиенту.
+// Возвращает текст для устаревшего поля error и ошибку gRPC: в режиме
+// совместимости ошибка равна nil, а текст передается в теле ответа.
+func (s *Server) handleError(ctx context.Context, err error) (string, error) {
+	st := s.toStatus(ctx, err)
+	if s.legacyErrorField && !wantsStatusErrors(ctx) {
+		return st.Message(), nil
+	}
+	return "", st.Err()
+}
+
+func wantsStatusErrors(ctx context.Context) bool {
+	values := metadata.ValueFromIncomingContext(ctx, statusErrorsHeader)
+	return len(values) > 0 && values[0] == "true"
+}
+
+// statusError возвращает ошибку gRPC для методов, у ответа которых нет
+// устаревшего поля error: они не зависят от режима совместимости.
+func (s *Server) statusError(ctx context.Context, err error) error {
+	return s.toStatus(ctx, err).Err()
+}
+
+// toStatus отображает ошибку в статус gRPC. Неизвестные ошибки скрываются
+// от клиента за INTERNAL и записываются в лог вместе с методом и request_id.
+func (s *Server) toStatus(ctx context.Context, err error) *status.Status {
+	var verr *errors.ValidationError
+	if stderrors.As(err, &verr) {
+		return validationStatus(verr)
+	}
+	var moved *errors.SlugMovedError
+	if stderrors.As(err, &moved) {
+		return slugMovedStatus(moved)
+	}
+
+	m, ok := findErrorMapping(err)
+	if !ok {
+		method, _ := grpc.Method(ctx)
+		s.logger.ErrorContext(ctx, "Unexpected error", "method", method, "error", err)
+		m = internalErrorMapping
+	}
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{Reason: m.reason, Domain: errorDomain},
+	}
+	if m.field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: m.field, Description: m.err.Error()},
+			},
+		})
+	}
+
+	st := status.New(m.code, m.message)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		return withDetails
+	}
+	return st
+}
+
+func findErrorMapping(err error) (errorMapping, bool) {
+	for _, m := range errorMappings {
+		if stderrors.Is(err, m.err) {
+			return m, true
+		}
+	}
+	return errorMapping{}, false
+}
+
+// validationStatus передает все нарушения в google.rpc.BadRequest,
+// а правило и его границу - в метаданных ErrorInfo по имени поля.
+func validationStatus(verr *errors.ValidationError) *status.Status {
+	badRequest := &errdetails.BadRequest{}
+	metadata := make(map[string]string, len(verr.Violations))
+	for _, v := range verr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+		rule := v.Rule
+		if v.Limit != 0 {
+			rule = fmt.Sprintf("%s:%d", v.Rule, v.Limit)
+		}
+		if prev, ok := metadata[v.Field]; ok {
+			rule = prev + "," + rule
+		}
+		metadata[v.Field] = rule
+	}
+
+	st := status.New(codes.InvalidArgument, verr.Error())
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: "INVALID_ARGUMENT", Domain: errorDomain, Metadata: metadata},
+		badRequest,
+	)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+// slugMovedStatus передает актуальный slug в метаданных ErrorInfo, чтобы
+// клиент мог повторить изменение, не разбирая текст ошибки.
+func slugMovedStatus(moved *errors.SlugMovedError) *status.Status {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("News was renamed, use slug %q", moved.Slug))
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   "SLUG_MOVED",
+		Domain:   errorDomain,
+		Metadata: map[string]string{"slug": moved.Slug},
+	})
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
@@ -0,0 +1,200 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"testing"
+
+	"news-service/pkg/errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newTestServer(opts ...Option) *Server {
+	s := &Server{logger: slog.New(slog.NewTextHandler(io.Discard, nil))}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// statusDetails разбирает детали статуса на ErrorInfo и BadRequest
+func statusDetails(t *testing.T, st *status.Status) (*errdetails.ErrorInfo, *errdetails.BadRequest) {
+	t.Helper()
+	var info *errdetails.ErrorInfo
+	var badRequest *errdetails.BadRequest
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *errdetails.BadRequest:
+			badRequest = d
+		default:
+			t.Errorf("Unexpected detail %T", d)
+		}
+	}
+	if info == nil {
+		t.Fatal("Expected ErrorInfo detail")
+	}
+	if info.Domain != errorDomain {
+		t.Errorf("Expected domain %q, got %q", errorDomain, info.Domain)
+	}
+	return info, badRequest
+}
+
+func TestToStatus_ErrorMappings(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+		field  string
+	}{
+		{errors.ErrNewsNotFound, codes.NotFound, "NEWS_NOT_FOUND", ""},
+		{errors.ErrRevisionNotFound, codes.NotFound, "REVISION_NOT_FOUND", ""},
+		{errors.ErrUnauthenticated, codes.Unauthenticated, "UNAUTHENTICATED", ""},
+		{errors.ErrPermissionDenied, codes.PermissionDenied, "PERMISSION_DENIED", ""},
+		{errors.ErrRateLimited, codes.ResourceExhausted, "RATE_LIMITED", ""},
+		{errors.ErrDuplicateSlug, codes.AlreadyExists, "DUPLICATE_SLUG", ""},
+		{errors.ErrConflict, codes.Aborted, "VERSION_CONFLICT", ""},
+		{errors.ErrInvalidSlug, codes.InvalidArgument, "INVALID_ARGUMENT", "slug"},
+		{errors.ErrInvalidTitle, codes.InvalidArgument, "INVALID_ARGUMENT", "title"},
+		{errors.ErrInvalidContent, codes.InvalidArgument, "INVALID_ARGUMENT", "content"},
+		{errors.ErrInvalidPagination, codes.InvalidArgument, "INVALID_ARGUMENT", "page"},
+		// Обернутые ошибки находятся через errors.Is
+		{fmt.Errorf("get news: %w", errors.ErrNewsNotFound), codes.NotFound, "NEWS_NOT_FOUND", ""},
+		// Неизвестные ошибки скрываются за INTERNAL
+		{fmt.Errorf("connection reset"), codes.Internal, "INTERNAL", ""},
+	}
+
+	s := newTestServer()
+	for _, tt := range tests {
+		st := s.toStatus(context.Background(), tt.err)
+
+		if st.Code() != tt.code {
+			t.Errorf("%v: expected code %s, got %s", tt.err, tt.code, st.Code())
+		}
+		info, badRequest := statusDetails(t, st)
+		if info.Reason != tt.reason {
+			t.Errorf("%v: expected reason %s, got %s", tt.err, tt.reason, info.Reason)
+		}
+		if tt.field == "" {
+			if badRequest != nil {
+				t.Errorf("%v: expected no BadRequest, got %v", tt.err, badRequest)
+			}
+			continue
+		}
+		if badRequest == nil || len(badRequest.FieldViolations) != 1 || badRequest.FieldViolations[0].Field != tt.field {
+			t.Errorf("%v: expected violation for %s, got %v", tt.err, tt.field, badRequest)
+		}
+	}
+}
+
+func TestToStatus_InternalErrorHidesMessage(t *testing.T) {
+	st := newTestServer().toStatus(context.Background(), fmt.Errorf("pq: password authentication failed"))
+
+	if st.Message() != "Internal server error" {
+		t.Errorf("Expected generic message, got %q", st.Message())
+	}
+}
+
+func TestToStatus_ValidationError(t *testing.T) {
+	verr := &errors.ValidationError{}
+	verr.Add("title", errors.RuleRequired, 0, "title is required")
+	verr.Add("content", errors.RuleMaxLength, 100, "content is too long")
+	verr.Add("content", errors.RulePattern, 0, "content contains control characters")
+	err := fmt.Errorf("create news: %w", verr)
+
+	st := newTestServer().toStatus(context.Background(), err)
+
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument, got %s", st.Code())
+	}
+	if st.Message() != verr.Error() {
+		t.Errorf("Expected message %q, got %q", verr.Error(), st.Message())
+	}
+	info, badRequest := statusDetails(t, st)
+	if info.Reason != "INVALID_ARGUMENT" {
+		t.Errorf("Expected INVALID_ARGUMENT reason, got %s", info.Reason)
+	}
+	wantMetadata := map[string]string{
+		"title":   "required",
+		"content": "max_length:100,pattern",
+	}
+	if len(info.Metadata) != len(wantMetadata) {
+		t.Errorf("Expected metadata %v, got %v", wantMetadata, info.Metadata)
+	}
+	for field, rule := range wantMetadata {
+		if info.Metadata[field] != rule {
+			t.Errorf("Expected metadata %s=%q, got %q", field, rule, info.Metadata[field])
+		}
+	}
+
+	if badRequest == nil || len(badRequest.FieldViolations) != 3 {
+		t.Fatalf("Expected 3 field violations, got %v", badRequest)
+	}
+	for i, v := range verr.Violations {
+		got := badRequest.FieldViolations[i]
+		if got.Field != v.Field || got.Description != v.Description {
+			t.Errorf("Violation %d: expected %s %q, got %s %q", i, v.Field, v.Description, got.Field, got.Description)
+		}
+	}
+}
+
+func TestHandleError_Modes(t *testing.T) {
+	tests := []struct {
+		name        string
+		legacy      bool
+		wantMessage string
+		wantCode    codes.Code
+	}{
+		{"status codes", false, "", codes.NotFound},
+		{"legacy error field", true, "News not found", codes.OK},
+	}
+
+	for _, tt := range tests {
+		s := newTestServer(WithLegacyErrorField(tt.legacy))
+
+		msg, err := s.handleError(context.Background(), errors.ErrNewsNotFound)
+
+		if msg != tt.wantMessage {
+			t.Errorf("%s: expected error field %q, got %q", tt.name, tt.wantMessage, msg)
+		}
+		if status.Code(err) != tt.wantCode {
+			t.Errorf("%s: expected code %s, got %v", tt.name, tt.wantCode, err)
+		}
+		// Методы без поля error всегда возвращают статус
+		if got := status.Code(s.statusError(context.Background(), errors.ErrNewsNotFound)); got != codes.NotFound {
+			t.Errorf("%s: expected statusError to return NotFound, got %s", tt.name, got)
+		}
+		// HTTP-шлюз всегда получает статусы
+		gateway := metadata.NewIncomingContext(context.Background(), metadata.Pairs(statusErrorsHeader, "true"))
+		if msg, err := s.handleError(gateway, errors.ErrNewsNotFound); msg != "" || status.Code(err) != codes.NotFound {
+			t.Errorf("%s: expected NotFound status for the gateway, got %q, %v", tt.name, msg, err)
+		}
+	}
+}
+
+func TestToStatus_SlugMoved(t *testing.T) {
+	err := fmt.Errorf("update news: %w", &errors.SlugMovedError{Slug: "new-slug"})
+
+	st := newTestServer().toStatus(context.Background(), err)
+
+	if st.Code() != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition, got %s", st.Code())
+	}
+	info, badRequest := statusDetails(t, st)
+	if info.Reason != "SLUG_MOVED" {
+		t.Errorf("Expected SLUG_MOVED reason, got %s", info.Reason)
+	}
+	if info.Metadata["slug"] != "new-slug" {
+		t.Errorf("Expected canonical slug in metadata, got %v", info.Metadata)
+	}
+	if badRequest != nil {
+		t.Errorf("Expected no BadRequest, got %v", badRequest)
+	}
+}
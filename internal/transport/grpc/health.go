@@ -0,0 +1,48 @@
+package grpc
+
+import (
+	"strings"
+
+	pb "news-service/proto/news"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ReadinessWatcher сообщает о готовности сервиса, см. health.Checker
+type ReadinessWatcher interface {
+	Watch(fn func(ready bool))
+}
+
+// WithHealth связывает статус grpc.health.v1 с проверками зависимостей:
+// пока они не проходят, сервис отвечает NOT_SERVING. Без этой опции статус
+// SERVING до вызова Stop.
+func WithHealth(watcher ReadinessWatcher) Option {
+	return func(s *Server) {
+		s.readiness = watcher
+	}
+}
+
+// newHealthServer создает сервис grpc.health.v1 со статусами для всего
+// сервера ("") и для NewsService
+func (s *Server) newHealthServer() *health.Server {
+	hs := health.NewServer()
+	if s.readiness != nil {
+		s.readiness.Watch(func(ready bool) {
+			status := healthpb.HealthCheckResponse_NOT_SERVING
+			if ready {
+				status = healthpb.HealthCheckResponse_SERVING
+			}
+			hs.SetServingStatus("", status)
+			hs.SetServingStatus(pb.NewsService_ServiceDesc.ServiceName, status)
+		})
+	} else {
+		hs.SetServingStatus(pb.NewsService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	}
+	return hs
+}
+
+// isHealthMethod - вызов проверки состояния от оркестратора или балансировщика
+func isHealthMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "news-service/proto/news"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// fakeReadiness запоминает подписчика, чтобы тест мог менять готовность
+type fakeReadiness struct {
+	fn func(ready bool)
+}
+
+func (r *fakeReadiness) Watch(fn func(ready bool)) {
+	r.fn = fn
+	fn(false)
+}
+
+func servingStatus(t *testing.T, s *Server, service string) healthpb.HealthCheckResponse_ServingStatus {
+	t.Helper()
+	resp, err := s.healthServer.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		t.Fatalf("Health check for %q failed: %v", service, err)
+	}
+	return resp.Status
+}
+
+func TestHealth_FollowsReadiness(t *testing.T) {
+	readiness := &fakeReadiness{}
+	s := NewServer(nil, WithHealth(readiness))
+
+	for _, service := range []string{"", pb.NewsService_ServiceDesc.ServiceName} {
+		if got := servingStatus(t, s, service); got != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Errorf("Expected %q to be NOT_SERVING before dependencies are ready, got %s", service, got)
+		}
+	}
+
+	readiness.fn(true)
+	if got := servingStatus(t, s, pb.NewsService_ServiceDesc.ServiceName); got != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("Expected SERVING when dependencies are ready, got %s", got)
+	}
+
+	if err := s.Stop(context.Background()); err != nil {
+		t.Fatalf("Expected stop without error, got %v", err)
+	}
+	if got := servingStatus(t, s, ""); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("Expected NOT_SERVING after Stop, got %s", got)
+	}
+}
+
+func TestHealth_NotRateLimited(t *testing.T) {
+	s := &Server{rateLimiter: newRateLimiter(RateLimitConfig{})}
+	info := &grpc.UnaryServerInfo{FullMethod: healthpb.Health_Check_FullMethodName}
+
+	for i := 0; i < 3; i++ {
+		_, err := s.rateLimitInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		if err != nil {
+			t.Fatalf("Expected health check %d to pass the rate limiter, got %v", i+1, err)
+		}
+	}
+}
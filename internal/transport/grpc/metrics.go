@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RPCObserver получает метод, код ответа и длительность каждого вызова
+type RPCObserver interface {
+	ObserveRPC(fullMethod string, code codes.Code, duration time.Duration)
+}
+
+// WithMetrics включает учет вызовов. Интерцептор стоит перед authInterceptor
+// и rateLimitInterceptor (порядок цепочки - в NewServer), поэтому учитываются
+// и вызовы, отклоненные проверкой токена или лимитом.
+func WithMetrics(observer RPCObserver) Option {
+	return func(s *Server) {
+		s.metrics = observer
+	}
+}
+
+func (s *Server) metricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if s.metrics == nil {
+		return handler(ctx, req)
+	}
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	s.metrics.ObserveRPC(info.FullMethod, status.Code(err), time.Since(start))
+	return resp, err
+}
@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "news-service/proto/news"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type recordingObserver struct {
+	method string
+	code   codes.Code
+}
+
+func (o *recordingObserver) ObserveRPC(fullMethod string, code codes.Code, duration time.Duration) {
+	o.method = fullMethod
+	o.code = code
+}
+
+func TestMetricsInterceptor_RecordsStatusCode(t *testing.T) {
+	observer := &recordingObserver{}
+	s := &Server{metrics: observer}
+	info := &grpc.UnaryServerInfo{FullMethod: pb.NewsService_GetNews_FullMethodName}
+
+	_, err := s.metricsInterceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "news not found")
+	})
+
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected handler error to be returned, got %v", err)
+	}
+	if observer.method != pb.NewsService_GetNews_FullMethodName || observer.code != codes.NotFound {
+		t.Errorf("Expected GetNews with NotFound to be observed, got %s %s", observer.method, observer.code)
+	}
+}
@@ -0,0 +1,131 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"news-service/pkg/errors"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RateLimit - параметры корзины токенов: RPS запросов в секунду
+// и до Burst запросов подряд
+type RateLimit struct {
+	RPS   float64
+	Burst int
+}
+
+// RateLimitConfig задает лимиты для каждого клиента
+type RateLimitConfig struct {
+	// Default применяется к методам без своей настройки
+	Default RateLimit
+	// Methods - лимиты по короткому имени метода, например "GetNewsList"
+	Methods map[string]RateLimit
+	// IdleTTL - через сколько забываются корзины неактивных клиентов
+	IdleTTL time.Duration
+}
+
+// retryAfterHeader - заголовок ответа с паузой до следующей попытки в секундах
+const retryAfterHeader = "retry-after"
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter хранит корзины токенов по паре клиент и метод
+type rateLimiter struct {
+	cfg RateLimitConfig
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+func newRateLimiter(cfg RateLimitConfig) *rateLimiter {
+	return &rateLimiter{
+		cfg:     cfg,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// allow расходует токен клиента для метода. Если токенов нет, возвращает
+// время, через которое запрос будет разрешен.
+func (l *rateLimiter) allow(client, method string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+
+	key := client + "|" + method
+	b, exists := l.buckets[key]
+	if !exists {
+		limit, ok := l.cfg.Methods[method]
+		if !ok {
+			limit = l.cfg.Default
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RPS), limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		// Нулевой burst запрещает метод полностью
+		return 0, false
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
+
+// sweep удаляет корзины клиентов, не обращавшихся дольше IdleTTL.
+// Полная корзина равносильна отсутствующей, поэтому лимиты не нарушаются.
+func (l *rateLimiter) sweep(now time.Time) {
+	if l.cfg.IdleTTL <= 0 || now.Sub(l.lastSweep) < l.cfg.IdleTTL {
+		return
+	}
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) > l.cfg.IdleTTL {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// rateLimitInterceptor ограничивает частоту вызовов для каждого клиента.
+// Должен выполняться после authInterceptor, чтобы знать пользователя.
+// Проверки состояния не ограничиваются: частые пробы не должны выводить
+// сервис из балансировки.
+func (s *Server) rateLimitInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if s.rateLimiter == nil || isHealthMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	method := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	delay, ok := s.rateLimiter.allow(ClientKey(ctx), method, time.Now())
+	if ok {
+		return handler(ctx, req)
+	}
+
+	st := s.toStatus(ctx, errors.ErrRateLimited)
+	if delay > 0 {
+		seconds := int(math.Ceil(delay.Seconds()))
+		_ = grpc.SetHeader(ctx, metadata.Pairs(retryAfterHeader, fmt.Sprint(seconds)))
+		if withRetry, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)}); err == nil {
+			st = withRetry
+		}
+	}
+	return nil, st.Err()
+}
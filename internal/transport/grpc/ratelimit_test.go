@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestRateLimiter_PerClientAndMethod(t *testing.T) {
+	l := newRateLimiter(RateLimitConfig{
+		Default: RateLimit{RPS: 1, Burst: 2},
+		Methods: map[string]RateLimit{"SearchNews": {RPS: 1, Burst: 1}},
+	})
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if _, ok := l.allow("user:alice", "GetNews", now); !ok {
+			t.Fatalf("Expected request %d within burst to be allowed", i+1)
+		}
+	}
+	delay, ok := l.allow("user:alice", "GetNews", now)
+	if ok {
+		t.Fatal("Expected request over burst to be rejected")
+	}
+	if delay <= 0 || delay > time.Second {
+		t.Errorf("Expected retry delay within a second, got %v", delay)
+	}
+
+	// Другой клиент и другой метод расходуют свои корзины
+	if _, ok := l.allow("user:bob", "GetNews", now); !ok {
+		t.Error("Expected another client to have its own bucket")
+	}
+	if _, ok := l.allow("user:alice", "SearchNews", now); !ok {
+		t.Error("Expected another method to have its own bucket")
+	}
+	if _, ok := l.allow("user:alice", "SearchNews", now); ok {
+		t.Error("Expected per-method burst to apply")
+	}
+
+	// Отклоненный запрос не расходует токен: через секунду запрос проходит
+	if _, ok := l.allow("user:alice", "GetNews", now.Add(time.Second)); !ok {
+		t.Error("Expected request to be allowed after refill")
+	}
+}
+
+func TestRateLimiter_ZeroBurstRejectsWithoutDelay(t *testing.T) {
+	l := newRateLimiter(RateLimitConfig{Methods: map[string]RateLimit{"DeleteNews": {}}})
+
+	delay, ok := l.allow("ip:127.0.0.1", "DeleteNews", time.Now())
+	if ok || delay != 0 {
+		t.Errorf("Expected rejection without retry delay, got ok=%t delay=%v", ok, delay)
+	}
+}
+
+func TestClientKey_TrustsForwardedForOnlyWithProxyKey(t *testing.T) {
+	s := newTestServer(WithProxyKey("proxy-key"))
+	peerCtx := func(md metadata.MD) context.Context {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		return peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 5000}})
+	}
+
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{"proxy with key", metadata.Pairs(forwardedForHeader, "203.0.113.7, 10.0.0.1", proxyKeyHeader, "proxy-key"), "ip:203.0.113.7"},
+		// Локальное соединение само по себе не дает права подменить адрес
+		{"loopback without key", metadata.Pairs(forwardedForHeader, "203.0.113.7"), "ip:127.0.0.1"},
+		{"wrong key", metadata.Pairs(forwardedForHeader, "203.0.113.7", proxyKeyHeader, "guess"), "ip:127.0.0.1"},
+		{"no forwarded address", metadata.Pairs(proxyKeyHeader, "proxy-key"), "ip:127.0.0.1"},
+	}
+
+	for _, tt := range tests {
+		var got string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			got = ClientKey(ctx)
+			return nil, nil
+		}
+		if _, err := s.clientAddrInterceptor(peerCtx(tt.md), nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+		}
+	}
+
+	// Без настроенного ключа x-forwarded-for не принимается вовсе
+	var got string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = ClientKey(ctx)
+		return nil, nil
+	}
+	ctx := peerCtx(metadata.Pairs(forwardedForHeader, "203.0.113.7", proxyKeyHeader, ""))
+	if _, err := newTestServer().clientAddrInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil || got != "ip:127.0.0.1" {
+		t.Errorf("Expected peer address without proxy key, got %q (%v)", got, err)
+	}
+}
+
+func TestCallerKey_SkipsUnknownClients(t *testing.T) {
+	if key := CallerKey(context.Background()); key != "" {
+		t.Errorf("Expected empty caller key for unknown client, got %q", key)
+	}
+
+	ctx := context.WithValue(context.Background(), clientAddrKey{}, "203.0.113.7")
+	if key := CallerKey(ctx); key != "ip:203.0.113.7" {
+		t.Errorf("Expected client address as caller key, got %q", key)
+	}
+}
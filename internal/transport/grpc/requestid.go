@@ -0,0 +1,79 @@
+package grpc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"news-service/internal/logger"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// requestIDHeader - идентификатор запроса во входящих и исходящих метаданных
+	requestIDHeader    = "x-request-id"
+	maxRequestIDLength = 128
+)
+
+// requestIDInterceptor берет идентификатор запроса из метаданных клиента или
+// создает новый, сохраняет его в контексте для логов и возвращает клиенту
+// в заголовке ответа
+func (s *Server) requestIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	requestID := ""
+	if values := metadata.ValueFromIncomingContext(ctx, requestIDHeader); len(values) > 0 && validRequestID(values[0]) {
+		requestID = values[0]
+	} else {
+		requestID = newRequestID()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+	return handler(logger.WithRequestID(ctx, requestID), req)
+}
+
+// loggingInterceptor записывает по строке на каждый вызов. Стоит после
+// трассировки, чтобы в строку попал trace_id.
+func (s *Server) loggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("request.id", logger.RequestID(ctx)))
+
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	// Проверки состояния приходят каждые несколько секунд и засоряли бы лог
+	level := slog.LevelInfo
+	if isHealthMethod(info.FullMethod) {
+		level = slog.LevelDebug
+	}
+	s.logger.Log(ctx, level, "RPC completed",
+		"method", info.FullMethod,
+		"code", status.Code(err).String(),
+		"duration", time.Since(start),
+	)
+	return resp, err
+}
+
+// validRequestID принимает непустые идентификаторы из печатных ASCII-символов
+// разумной длины, чтобы клиент не мог подмешать в логи произвольный текст
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"news-service/internal/logger"
+	pb "news-service/proto/news"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeStream позволяет grpc.Method и grpc.SetHeader работать вне сервера
+type fakeStream struct {
+	method string
+	header metadata.MD
+}
+
+func (s *fakeStream) Method() string { return s.method }
+func (s *fakeStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+func (s *fakeStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+func (s *fakeStream) SetTrailer(md metadata.MD) error { return nil }
+
+func TestRequestIDInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{"client id is kept", "client-req-42", true},
+		{"missing id is generated", "", false},
+		{"id with spaces is replaced", "bad id\nline", false},
+		{"overlong id is replaced", strings.Repeat("a", maxRequestIDLength+1), false},
+	}
+
+	for _, tt := range tests {
+		stream := &fakeStream{method: pb.NewsService_GetNews_FullMethodName}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		if tt.incoming != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(requestIDHeader, tt.incoming))
+		}
+
+		var got string
+		s := &Server{}
+		_, _ = s.requestIDInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			got = logger.RequestID(ctx)
+			return nil, nil
+		})
+
+		if tt.keep && got != tt.incoming {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.incoming, got)
+		}
+		if !tt.keep && (got == tt.incoming || !validRequestID(got)) {
+			t.Errorf("%s: expected generated id, got %q", tt.name, got)
+		}
+		if header := stream.header.Get(requestIDHeader); len(header) != 1 || header[0] != got {
+			t.Errorf("%s: expected id in response header, got %v", tt.name, header)
+		}
+	}
+}
+
+func TestToStatus_LogsUnexpectedErrorWithMethodAndRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	log, err := logger.New(logger.Config{Level: "info", Format: logger.FormatJSON}, &buf)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	s := &Server{logger: log}
+
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), &fakeStream{method: pb.NewsService_GetNews_FullMethodName})
+	ctx = logger.WithRequestID(ctx, "req-7")
+
+	st := s.toStatus(ctx, fmt.Errorf("connection reset"))
+
+	if st.Code() != codes.Internal {
+		t.Fatalf("Expected INTERNAL, got %s", st.Code())
+	}
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("Expected one JSON log line, got %q", buf.String())
+	}
+	if line["msg"] != "Unexpected error" || line["method"] != pb.NewsService_GetNews_FullMethodName ||
+		line["request_id"] != "req-7" || line["error"] != "connection reset" {
+		t.Errorf("Unexpected log line: %v", line)
+	}
+}
@@ -3,15 +3,21 @@ package grpc
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
+	"time"
 
+	"news-service/internal/auth"
 	"news-service/internal/domain"
+	"news-service/internal/repository"
 	"news-service/internal/service"
+	"news-service/pkg/diff"
 	"news-service/pkg/errors"
 	pb "news-service/proto/news"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -19,13 +25,70 @@ type Server struct {
 	pb.UnimplementedNewsServiceServer // Добавили встраивание
 	newsService                       *service.NewsService
 	grpcServer                        *grpc.Server
+	legacyErrorField                  bool
+	verifier                          *auth.Verifier
+	rateLimiter                       *rateLimiter
+	metrics                           RPCObserver
+	logger                            *slog.Logger
+	readiness                         ReadinessWatcher
+	healthServer                      *health.Server
+	proxyKey                          string
 }
 
-func NewServer(newsService *service.NewsService) *Server {
-	return &Server{
+// Option настраивает Server
+type Option func(*Server)
+
+// WithLegacyErrorField включает режим совместимости: ошибки передаются
+// строкой в поле error ответа со статусом OK, как до перехода на коды gRPC.
+func WithLegacyErrorField(enabled bool) Option {
+	return func(s *Server) {
+		s.legacyErrorField = enabled
+	}
+}
+
+// WithLogger задает логгер сервера; по умолчанию используется slog.Default()
+func WithLogger(logger *slog.Logger) Option {
+	return func(s *Server) {
+		s.logger = logger
+	}
+}
+
+// WithAuth включает проверку JWT и ролей для всех методов
+func WithAuth(verifier *auth.Verifier) Option {
+	return func(s *Server) {
+		s.verifier = verifier
+	}
+}
+
+// WithRateLimit включает ограничение частоты вызовов для каждого клиента
+func WithRateLimit(cfg RateLimitConfig) Option {
+	return func(s *Server) {
+		s.rateLimiter = newRateLimiter(cfg)
+	}
+}
+
+func NewServer(newsService *service.NewsService, opts ...Option) *Server {
+	s := &Server{
 		newsService: newsService,
-		grpcServer:  grpc.NewServer(),
+		logger:      slog.Default(),
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	// Порядок важен: request_id и адрес клиента нужны всем следующим шагам;
+	// логи и метрики стоят до проверки токена и лимита, чтобы видеть и
+	// отклоненные вызовы; лимит считается после auth, по пользователю
+	s.grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(
+		s.requestIDInterceptor,
+		s.clientAddrInterceptor,
+		s.tracingInterceptor,
+		s.loggingInterceptor,
+		s.metricsInterceptor,
+		s.authInterceptor,
+		s.rateLimitInterceptor,
+	))
+	s.healthServer = s.newHealthServer()
+	return s
 }
 
 func (s *Server) Start(address string) error {
@@ -36,26 +99,50 @@ func (s *Server) Start(address string) error {
 
 	// ГЛАВНОЕ ИЗМЕНЕНИЕ: Регистрируем сервис!
 	pb.RegisterNewsServiceServer(s.grpcServer, s)
+	healthpb.RegisterHealthServer(s.grpcServer, s.healthServer)
 	reflection.Register(s.grpcServer) // Для удобства тестирования
 
-	log.Printf("gRPC server starting on %s", address)
-	log.Printf("NewsService registered successfully")
-
 	return s.grpcServer.Serve(listener)
 }
 
-func (s *Server) Stop() {
-	s.grpcServer.GracefulStop()
+// Stop переводит проверки состояния в NOT_SERVING и дожидается завершения
+// текущих вызовов. Если они не успевают до отмены ctx (например, завис поток),
+// соединения закрываются принудительно.
+func (s *Server) Stop(ctx context.Context) error {
+	s.healthServer.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.grpcServer.Stop()
+		<-stopped
+		return fmt.Errorf("graceful stop interrupted, connections closed: %w", ctx.Err())
+	}
 }
 
 // Изменяем сигнатуры методов на protobuf типы
 
 func (s *Server) CreateNews(ctx context.Context, req *pb.CreateNewsRequest) (*pb.CreateNewsResponse, error) {
-	news, err := s.newsService.CreateNews(ctx, req.Slug, req.Title, req.Content)
+	status, err := newsStatusFromProto(req.Status)
 	if err != nil {
-		return &pb.CreateNewsResponse{
-			Error: s.handleError(err),
-		}, nil
+		msg, err := s.handleError(ctx, err)
+		return &pb.CreateNewsResponse{Error: msg}, err
+	}
+
+	news, err := s.newsService.CreateNews(ctx, req.Slug, req.Title, req.Content, service.Publication{
+		Status:    status,
+		PublishAt: unixOrZero(req.PublishAt),
+	})
+	if err != nil {
+		msg, err := s.handleError(ctx, err)
+		return &pb.CreateNewsResponse{Error: msg}, err
 	}
 
 	return &pb.CreateNewsResponse{
@@ -64,43 +151,67 @@ func (s *Server) CreateNews(ctx context.Context, req *pb.CreateNewsRequest) (*pb
 }
 
 func (s *Server) GetNews(ctx context.Context, req *pb.GetNewsRequest) (*pb.GetNewsResponse, error) {
-	news, err := s.newsService.GetNews(ctx, req.Slug)
+	news, err := s.newsService.GetNews(ctx, req.Slug, req.IncludeUnpublished)
 	if err != nil {
-		return &pb.GetNewsResponse{
-			Error: s.handleError(err),
-		}, nil
+		msg, err := s.handleError(ctx, err)
+		return &pb.GetNewsResponse{Error: msg}, err
 	}
 
 	return &pb.GetNewsResponse{
-		News: s.domainToProto(news),
+		News:       s.domainToProto(news),
+		Redirected: news.Slug != req.Slug,
 	}, nil
 }
 
 func (s *Server) GetNewsList(ctx context.Context, req *pb.GetNewsListRequest) (*pb.GetNewsListResponse, error) {
-	newsList, total, err := s.newsService.GetNewsList(ctx, int(req.Page), int(req.Limit))
+	sort, err := listSortFromRequest(req)
 	if err != nil {
-		return &pb.GetNewsListResponse{
-			Error: s.handleError(err),
-		}, nil
+		msg, err := s.handleError(ctx, err)
+		return &pb.GetNewsListResponse{Error: msg}, err
 	}
 
-	protoNews := make([]*pb.News, len(newsList))
-	for i, news := range newsList {
-		protoNews[i] = s.domainToProto(news)
+	result, err := s.newsService.GetNewsList(ctx, service.ListParams{
+		Page:      int(req.Page),
+		Limit:     int(req.Limit),
+		PageToken: req.PageToken,
+		SkipTotal: req.SkipTotal,
+		Filter: repository.ListFilter{
+			CreatedFrom: unixOrZero(req.CreatedAfter),
+			CreatedTo:   unixOrZero(req.CreatedBefore),
+			UpdatedFrom: unixOrZero(req.UpdatedAfter),
+			UpdatedTo:   unixOrZero(req.UpdatedBefore),
+			TitlePrefix: req.TitlePrefix,
+			Tags:        req.Tags,
+			CreatedBy:   req.CreatedBy,
+			UpdatedBy:   req.UpdatedBy,
+
+			IncludeUnpublished: req.IncludeUnpublished,
+		},
+		Sort: sort,
+	})
+	if err != nil {
+		msg, err := s.handleError(ctx, err)
+		return &pb.GetNewsListResponse{Error: msg}, err
 	}
 
 	return &pb.GetNewsListResponse{
-		News:  protoNews,
-		Total: total,
+		News:          s.newsListToProto(result.News),
+		Total:         result.Total,
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
 func (s *Server) UpdateNews(ctx context.Context, req *pb.UpdateNewsRequest) (*pb.UpdateNewsResponse, error) {
-	news, err := s.newsService.UpdateNews(ctx, req.Slug, req.Title, req.Content)
+	patch, err := newsPatchFromRequest(req)
 	if err != nil {
-		return &pb.UpdateNewsResponse{
-			Error: s.handleError(err),
-		}, nil
+		msg, err := s.handleError(ctx, err)
+		return &pb.UpdateNewsResponse{Error: msg}, err
+	}
+
+	news, err := s.newsService.UpdateNews(ctx, req.Slug, patch)
+	if err != nil {
+		msg, err := s.handleError(ctx, err)
+		return &pb.UpdateNewsResponse{Error: msg}, err
 	}
 
 	return &pb.UpdateNewsResponse{
@@ -109,12 +220,10 @@ func (s *Server) UpdateNews(ctx context.Context, req *pb.UpdateNewsRequest) (*pb
 }
 
 func (s *Server) DeleteNews(ctx context.Context, req *pb.DeleteNewsRequest) (*pb.DeleteNewsResponse, error) {
-	err := s.newsService.DeleteNews(ctx, req.Slug)
+	err := s.newsService.DeleteNews(ctx, req.Slug, req.ExpectedVersion)
 	if err != nil {
-		return &pb.DeleteNewsResponse{
-			Success: false,
-			Error:   s.handleError(err),
-		}, nil
+		msg, err := s.handleError(ctx, err)
+		return &pb.DeleteNewsResponse{Success: false, Error: msg}, err
 	}
 
 	return &pb.DeleteNewsResponse{
@@ -122,37 +231,307 @@ func (s *Server) DeleteNews(ctx context.Context, req *pb.DeleteNewsRequest) (*pb
 	}, nil
 }
 
+func (s *Server) RenameNews(ctx context.Context, req *pb.RenameNewsRequest) (*pb.RenameNewsResponse, error) {
+	news, err := s.newsService.RenameNews(ctx, req.Slug, req.NewSlug, req.ExpectedVersion)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.RenameNewsResponse{
+		News: s.domainToProto(news),
+	}, nil
+}
+
+func (s *Server) RestoreNews(ctx context.Context, req *pb.RestoreNewsRequest) (*pb.RestoreNewsResponse, error) {
+	news, err := s.newsService.RestoreNews(ctx, req.Slug)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.RestoreNewsResponse{
+		News: s.domainToProto(news),
+	}, nil
+}
+
+func (s *Server) ListDeletedNews(ctx context.Context, req *pb.ListDeletedNewsRequest) (*pb.ListDeletedNewsResponse, error) {
+	newsList, total, err := s.newsService.ListDeletedNews(ctx, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.ListDeletedNewsResponse{
+		News:  s.newsListToProto(newsList),
+		Total: total,
+	}, nil
+}
+
+func (s *Server) SearchNews(ctx context.Context, req *pb.SearchNewsRequest) (*pb.SearchNewsResponse, error) {
+	hits, total, err := s.newsService.SearchNews(ctx, req.Query, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	results := make([]*pb.SearchNewsResult, len(hits))
+	for i, hit := range hits {
+		results[i] = &pb.SearchNewsResult{
+			News:           s.domainToProto(hit.News),
+			Rank:           float32(hit.Rank),
+			TitleHighlight: hit.TitleHighlight,
+			Snippet:        hit.Snippet,
+		}
+	}
+
+	return &pb.SearchNewsResponse{
+		Results: results,
+		Total:   total,
+	}, nil
+}
+
+func (s *Server) SetNewsTags(ctx context.Context, req *pb.SetNewsTagsRequest) (*pb.SetNewsTagsResponse, error) {
+	news, err := s.newsService.SetNewsTags(ctx, req.Slug, req.Tags)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.SetNewsTagsResponse{
+		News: s.domainToProto(news),
+	}, nil
+}
+
+func (s *Server) ListTags(ctx context.Context, req *pb.ListTagsRequest) (*pb.ListTagsResponse, error) {
+	tags, err := s.newsService.ListTags(ctx)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	protoTags := make([]*pb.TagCount, len(tags))
+	for i, tag := range tags {
+		protoTags[i] = &pb.TagCount{Name: tag.Name, Count: tag.Count}
+	}
+	return &pb.ListTagsResponse{Tags: protoTags}, nil
+}
+
+func (s *Server) PublishNews(ctx context.Context, req *pb.PublishNewsRequest) (*pb.PublishNewsResponse, error) {
+	news, err := s.newsService.PublishNews(ctx, req.Slug, unixOrZero(req.PublishAt), req.ExpectedVersion)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.PublishNewsResponse{
+		News: s.domainToProto(news),
+	}, nil
+}
+
+func (s *Server) UnpublishNews(ctx context.Context, req *pb.UnpublishNewsRequest) (*pb.UnpublishNewsResponse, error) {
+	news, err := s.newsService.UnpublishNews(ctx, req.Slug, req.Archive, req.ExpectedVersion)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.UnpublishNewsResponse{
+		News: s.domainToProto(news),
+	}, nil
+}
+
+func (s *Server) ListNewsRevisions(ctx context.Context, req *pb.ListNewsRevisionsRequest) (*pb.ListNewsRevisionsResponse, error) {
+	revisions, total, err := s.newsService.ListRevisions(ctx, req.Slug, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	protoRevisions := make([]*pb.NewsRevision, len(revisions))
+	for i, revision := range revisions {
+		protoRevisions[i] = revisionToProto(revision)
+	}
+	return &pb.ListNewsRevisionsResponse{
+		Revisions: protoRevisions,
+		Total:     total,
+	}, nil
+}
+
+func (s *Server) GetNewsRevision(ctx context.Context, req *pb.GetNewsRevisionRequest) (*pb.GetNewsRevisionResponse, error) {
+	revision, err := s.newsService.GetRevision(ctx, req.Slug, req.Version)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.GetNewsRevisionResponse{
+		Revision: revisionToProto(revision),
+	}, nil
+}
+
+func (s *Server) DiffNewsRevisions(ctx context.Context, req *pb.DiffNewsRevisionsRequest) (*pb.DiffNewsRevisionsResponse, error) {
+	d, err := s.newsService.DiffRevisions(ctx, req.Slug, req.FromVersion, req.ToVersion)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.DiffNewsRevisionsResponse{
+		From:    revisionToProto(d.From),
+		To:      revisionToProto(d.To),
+		Title:   diffToProto(d.Title),
+		Content: diffToProto(d.Content),
+	}, nil
+}
+
+func (s *Server) RollbackNews(ctx context.Context, req *pb.RollbackNewsRequest) (*pb.RollbackNewsResponse, error) {
+	news, err := s.newsService.RollbackNews(ctx, req.Slug, req.Version, req.ExpectedVersion)
+	if err != nil {
+		return nil, s.statusError(ctx, err)
+	}
+
+	return &pb.RollbackNewsResponse{
+		News: s.domainToProto(news),
+	}, nil
+}
+
+func revisionToProto(revision *domain.Revision) *pb.NewsRevision {
+	return &pb.NewsRevision{
+		Slug:      revision.Slug,
+		Version:   revision.Version,
+		Title:     revision.Title,
+		Content:   revision.Content,
+		Author:    revision.Author,
+		CreatedAt: revision.CreatedAt.Unix(),
+	}
+}
+
+var diffOps = map[diff.Op]pb.DiffOp{
+	diff.Equal:  pb.DiffOp_DIFF_OP_EQUAL,
+	diff.Insert: pb.DiffOp_DIFF_OP_INSERT,
+	diff.Delete: pb.DiffOp_DIFF_OP_DELETE,
+}
+
+func diffToProto(lines []diff.Line) []*pb.DiffLine {
+	protoLines := make([]*pb.DiffLine, len(lines))
+	for i, line := range lines {
+		protoLines[i] = &pb.DiffLine{Op: diffOps[line.Op], Text: line.Text}
+	}
+	return protoLines
+}
+
+var newsStatuses = map[pb.NewsStatus]domain.NewsStatus{
+	pb.NewsStatus_NEWS_STATUS_UNSPECIFIED: "",
+	pb.NewsStatus_NEWS_STATUS_DRAFT:       domain.StatusDraft,
+	pb.NewsStatus_NEWS_STATUS_SCHEDULED:   domain.StatusScheduled,
+	pb.NewsStatus_NEWS_STATUS_PUBLISHED:   domain.StatusPublished,
+	pb.NewsStatus_NEWS_STATUS_ARCHIVED:    domain.StatusArchived,
+}
+
+func newsStatusFromProto(status pb.NewsStatus) (domain.NewsStatus, error) {
+	if s, ok := newsStatuses[status]; ok {
+		return s, nil
+	}
+	verr := &errors.ValidationError{}
+	verr.Add("status", errors.RuleAllowedValues, 0, fmt.Sprintf("unsupported status %d", status))
+	return "", verr
+}
+
+func newsStatusToProto(status domain.NewsStatus) pb.NewsStatus {
+	for p, s := range newsStatuses {
+		if s == status && s != "" {
+			return p
+		}
+	}
+	return pb.NewsStatus_NEWS_STATUS_UNSPECIFIED
+}
+
+var sortFields = map[pb.SortField]repository.SortField{
+	pb.SortField_SORT_FIELD_UNSPECIFIED: repository.SortByCreatedAt,
+	pb.SortField_SORT_FIELD_CREATED_AT:  repository.SortByCreatedAt,
+	pb.SortField_SORT_FIELD_UPDATED_AT:  repository.SortByUpdatedAt,
+	pb.SortField_SORT_FIELD_TITLE:       repository.SortByTitle,
+}
+
+// listSortFromRequest переводит сортировку из запроса; без явного порядка
+// даты сортируются по убыванию, а заголовок - по возрастанию
+func listSortFromRequest(req *pb.GetNewsListRequest) (repository.ListSort, error) {
+	verr := &errors.ValidationError{}
+	field, ok := sortFields[req.SortBy]
+	if !ok {
+		verr.Add("sort_by", errors.RuleAllowedValues, 0, fmt.Sprintf("unsupported sort field %d", req.SortBy))
+	}
+
+	sort := repository.ListSort{Field: field}
+	switch req.SortOrder {
+	case pb.SortOrder_SORT_ORDER_UNSPECIFIED:
+		sort.Ascending = field == repository.SortByTitle
+	case pb.SortOrder_SORT_ORDER_ASC:
+		sort.Ascending = true
+	case pb.SortOrder_SORT_ORDER_DESC:
+	default:
+		verr.Add("sort_order", errors.RuleAllowedValues, 0, fmt.Sprintf("unsupported sort order %d", req.SortOrder))
+	}
+	return sort, verr.Err()
+}
+
+// unixOrZero переводит Unix timestamp в time.Time; 0 означает "не задано"
+func unixOrZero(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
+
+// newsPatchFromRequest строит патч по update_mask. Без маски обновляются
+// оба поля, как в версии API без частичных обновлений.
+func newsPatchFromRequest(req *pb.UpdateNewsRequest) (domain.NewsPatch, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return domain.NewsPatch{
+			Title:           &req.Title,
+			Content:         &req.Content,
+			ExpectedVersion: req.ExpectedVersion,
+		}, nil
+	}
+
+	patch := domain.NewsPatch{ExpectedVersion: req.ExpectedVersion}
+	verr := &errors.ValidationError{}
+	for _, path := range paths {
+		switch path {
+		case "title":
+			patch.Title = &req.Title
+		case "content":
+			patch.Content = &req.Content
+		default:
+			verr.Add("update_mask", errors.RuleAllowedValues, 0,
+				fmt.Sprintf("field %q can not be updated", path))
+		}
+	}
+	return patch, verr.Err()
+}
+
 // Изменяем только возвращаемый тип
 func (s *Server) domainToProto(news *domain.News) *pb.News {
 	if news == nil {
 		return nil
 	}
 
-	return &pb.News{
+	protoNews := &pb.News{
 		Slug:      news.Slug,
 		Title:     news.Title,
 		Content:   news.Content,
 		CreatedAt: news.CreatedAt.Unix(),
 		UpdatedAt: news.UpdatedAt.Unix(),
+		Version:   news.Version,
+		Tags:      news.Tags,
+		Status:    newsStatusToProto(news.Status),
+		CreatedBy: news.CreatedBy,
+		UpdatedBy: news.UpdatedBy,
+	}
+	if news.PublishAt != nil {
+		protoNews.PublishAt = news.PublishAt.Unix()
+	}
+	if news.DeletedAt != nil {
+		protoNews.DeletedAt = news.DeletedAt.Unix()
 	}
+	return protoNews
 }
 
-func (s *Server) handleError(err error) string {
-	switch err {
-	case errors.ErrNewsNotFound:
-		return "News not found"
-	case errors.ErrDuplicateSlug:
-		return "News with this slug already exists"
-	case errors.ErrInvalidSlug:
-		return "Invalid slug format"
-	case errors.ErrInvalidTitle:
-		return "Invalid title"
-	case errors.ErrInvalidContent:
-		return "Invalid content"
-	case errors.ErrInvalidPagination:
-		return "Invalid pagination parameters"
-	default:
-		log.Printf("Unexpected error: %v", err)
-		return "Internal server error"
+func (s *Server) newsListToProto(newsList []*domain.News) []*pb.News {
+	protoNews := make([]*pb.News, len(newsList))
+	for i, news := range newsList {
+		protoNews[i] = s.domainToProto(news)
 	}
+	return protoNews
 }
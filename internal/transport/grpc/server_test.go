@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"news-service/internal/cache"
+	"news-service/internal/domain"
+	"news-service/internal/repository"
+	"news-service/internal/service"
+	pb "news-service/proto/news"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// searchRepository отвечает на Search заранее заданными результатами и
+// запоминает параметры вызова; остальные методы репозитория не нужны
+type searchRepository struct {
+	repository.NewsRepository
+	hits          []*domain.SearchHit
+	total         int64
+	query         string
+	offset, limit int
+}
+
+func (r *searchRepository) Search(ctx context.Context, query string, offset, limit int) ([]*domain.SearchHit, int64, error) {
+	r.query, r.offset, r.limit = query, offset, limit
+	return r.hits, r.total, nil
+}
+
+func newSearchServer(t *testing.T, repo *searchRepository) *Server {
+	t.Helper()
+	c := cache.New(time.Minute)
+	t.Cleanup(c.Stop)
+	s := newTestServer()
+	s.newsService = service.NewNewsService(repo, c)
+	return s
+}
+
+func TestServer_SearchNews(t *testing.T) {
+	news := &domain.News{Slug: "go-news", Title: "Go 1.23", Status: domain.StatusPublished}
+	repo := &searchRepository{
+		hits:  []*domain.SearchHit{{News: news, Rank: 0.5, TitleHighlight: "<b>Go</b> 1.23", Snippet: "… <b>Go</b> …"}},
+		total: 7,
+	}
+	s := newSearchServer(t, repo)
+
+	resp, err := s.SearchNews(context.Background(), &pb.SearchNewsRequest{Query: " go ", Page: 3, Limit: 2})
+	if err != nil {
+		t.Fatalf("Failed to search news: %v", err)
+	}
+
+	if repo.query != "go" || repo.offset != 4 || repo.limit != 2 {
+		t.Errorf("Expected trimmed query with offset 4 and limit 2, got %q, %d, %d", repo.query, repo.offset, repo.limit)
+	}
+	if resp.Total != 7 || len(resp.Results) != 1 {
+		t.Fatalf("Expected 1 result of 7, got %d of %d", len(resp.Results), resp.Total)
+	}
+	result := resp.Results[0]
+	if result.News.GetSlug() != "go-news" || result.Rank != 0.5 ||
+		result.TitleHighlight != "<b>Go</b> 1.23" || result.Snippet != "… <b>Go</b> …" {
+		t.Errorf("Unexpected result: %v", result)
+	}
+}
+
+func TestServer_SearchNewsRejectsInvalidRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    *pb.SearchNewsRequest
+		fields []string
+	}{
+		{"empty query", &pb.SearchNewsRequest{Page: 1, Limit: 10}, []string{"query"}},
+		{"zero page", &pb.SearchNewsRequest{Query: "go", Limit: 10}, []string{"page"}},
+		{"limit above max", &pb.SearchNewsRequest{Query: "go", Page: 1, Limit: 1000}, []string{"limit"}},
+	}
+
+	for _, tt := range tests {
+		repo := &searchRepository{}
+		// В режиме совместимости у SearchNews нет поля error: ошибка всегда статусом
+		s := newSearchServer(t, repo)
+		s.legacyErrorField = true
+
+		_, err := s.SearchNews(context.Background(), tt.req)
+
+		st := status.Convert(err)
+		if st.Code() != codes.InvalidArgument {
+			t.Errorf("%s: expected InvalidArgument, got %v", tt.name, err)
+			continue
+		}
+		_, badRequest := statusDetails(t, st)
+		if len(badRequest.GetFieldViolations()) != len(tt.fields) {
+			t.Errorf("%s: expected violations %v, got %v", tt.name, tt.fields, badRequest.GetFieldViolations())
+			continue
+		}
+		for i, field := range tt.fields {
+			if got := badRequest.FieldViolations[i].Field; got != field {
+				t.Errorf("%s: expected %s violation, got %s", tt.name, field, got)
+			}
+		}
+		if repo.limit != 0 {
+			t.Errorf("%s: expected repository not to be called", tt.name)
+		}
+	}
+}
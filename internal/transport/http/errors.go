@@ -0,0 +1,82 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	// Типы деталей ошибок должны быть зарегистрированы для кодирования в JSON
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// errorBody - тело ответа с ошибкой, одинаковое для всех маршрутов:
+//
+//	{"error": {"code": 404, "status": "NOT_FOUND", "message": "...", "details": [...]}}
+type errorBody struct {
+	Error errorStatus `json:"error"`
+}
+
+type errorStatus struct {
+	Code    int               `json:"code"`
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Details []json.RawMessage `json:"details"`
+}
+
+// httpStatusCodes - соответствие кодов gRPC кодам HTTP. FailedPrecondition
+// (изменение по старому slug переименованной новости) - 409: запрос
+// конфликтует с текущим состоянием ресурса, сам запрос корректен.
+var httpStatusCodes = map[codes.Code]int{
+	codes.OK:                 http.StatusOK,
+	codes.Canceled:           499,
+	codes.Unknown:            http.StatusInternalServerError,
+	codes.InvalidArgument:    http.StatusBadRequest,
+	codes.DeadlineExceeded:   http.StatusGatewayTimeout,
+	codes.NotFound:           http.StatusNotFound,
+	codes.AlreadyExists:      http.StatusConflict,
+	codes.PermissionDenied:   http.StatusForbidden,
+	codes.ResourceExhausted:  http.StatusTooManyRequests,
+	codes.FailedPrecondition: http.StatusConflict,
+	codes.Aborted:            http.StatusConflict,
+	codes.OutOfRange:         http.StatusBadRequest,
+	codes.Unimplemented:      http.StatusNotImplemented,
+	codes.Internal:           http.StatusInternalServerError,
+	codes.Unavailable:        http.StatusServiceUnavailable,
+	codes.DataLoss:           http.StatusInternalServerError,
+	codes.Unauthenticated:    http.StatusUnauthorized,
+}
+
+func httpStatusFromCode(c codes.Code) int {
+	if s, ok := httpStatusCodes[c]; ok {
+		return s
+	}
+	return http.StatusInternalServerError
+}
+
+func writeError(w http.ResponseWriter, st *status.Status) {
+	httpCode := httpStatusFromCode(st.Code())
+	body := errorBody{Error: errorStatus{
+		Code:    httpCode,
+		Status:  code.Code(st.Code()).String(),
+		Message: st.Message(),
+		Details: []json.RawMessage{},
+	}}
+	for _, detail := range st.Proto().GetDetails() {
+		raw, err := protojson.Marshal(detail)
+		if err != nil {
+			continue
+		}
+		body.Error.Details = append(body.Error.Details, raw)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if st.Code() == codes.Unauthenticated {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+	}
+	w.WriteHeader(httpCode)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,299 @@
+package http
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"news-service/internal/tracing"
+	pb "news-service/proto/news"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// maxBodySize ограничивает размер тела запроса
+const maxBodySize = 1 << 20
+
+// route связывает HTTP-метод и путь с методом NewsService. Параметры пути
+// ({slug}, {version}) заполняют одноименные поля запроса, остальные поля
+// берутся из JSON-тела (POST, PUT, PATCH) или из строки запроса.
+type route struct {
+	method  string
+	pattern string
+	rpc     string
+}
+
+var routes = []route{
+	{http.MethodPost, "/v1/news", "CreateNews"},
+	{http.MethodGet, "/v1/news", "GetNewsList"},
+	{http.MethodGet, "/v1/news/{slug}", "GetNews"},
+	{http.MethodPatch, "/v1/news/{slug}", "UpdateNews"},
+	{http.MethodDelete, "/v1/news/{slug}", "DeleteNews"},
+	{http.MethodPost, "/v1/news/{slug}/rename", "RenameNews"},
+	{http.MethodPost, "/v1/news/{slug}/restore", "RestoreNews"},
+	{http.MethodPut, "/v1/news/{slug}/tags", "SetNewsTags"},
+	{http.MethodPost, "/v1/news/{slug}/publish", "PublishNews"},
+	{http.MethodPost, "/v1/news/{slug}/unpublish", "UnpublishNews"},
+	{http.MethodGet, "/v1/news/{slug}/revisions", "ListNewsRevisions"},
+	{http.MethodGet, "/v1/news/{slug}/revisions/{version}", "GetNewsRevision"},
+	{http.MethodGet, "/v1/news/{slug}/diff", "DiffNewsRevisions"},
+	{http.MethodPost, "/v1/news/{slug}/rollback", "RollbackNews"},
+	{http.MethodGet, "/v1/trash", "ListDeletedNews"},
+	{http.MethodGet, "/v1/search", "SearchNews"},
+	{http.MethodGet, "/v1/tags", "ListTags"},
+}
+
+var tracer = otel.Tracer("news-service/internal/transport/http")
+
+var (
+	unmarshalOptions = protojson.UnmarshalOptions{}
+	marshalOptions   = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+)
+
+// Gateway - REST/JSON API поверх gRPC. Запросы проксируются в gRPC-сервер
+// как обычные вызовы клиента, поэтому аутентификация, лимиты и коды ошибок
+// у обоих API одинаковые.
+type Gateway struct {
+	conn       grpc.ClientConnInterface
+	closer     io.Closer
+	mux        *http.ServeMux
+	httpServer *http.Server
+	// proxyKey подтверждает gRPC-серверу, что x-forwarded-for задан шлюзом
+	proxyKey string
+}
+
+// NewGateway создает шлюз на address к gRPC-серверу по адресу grpcAddress.
+// proxyKey должен совпадать с ключом прокси gRPC-сервера, иначе адреса
+// клиентов не будут учтены и все запросы через шлюз разделят один лимит.
+func NewGateway(address, grpcAddress, proxyKey string) (*Gateway, error) {
+	conn, err := grpc.NewClient(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client for %s: %w", grpcAddress, err)
+	}
+
+	g := newGateway(conn)
+	g.closer = conn
+	g.proxyKey = proxyKey
+	// Сервер создается здесь, а не в Start: Stop может быть вызван из другой
+	// горутины раньше, чем Start начнет принимать запросы
+	g.httpServer = &http.Server{
+		Addr:              address,
+		Handler:           g,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return g, nil
+}
+
+func newGateway(conn grpc.ClientConnInterface) *Gateway {
+	g := &Gateway{
+		conn: conn,
+		mux:  http.NewServeMux(),
+	}
+
+	service := pb.File_proto_news_news_proto.Services().ByName("NewsService")
+	for _, rt := range routes {
+		method := service.Methods().ByName(protoreflect.Name(rt.rpc))
+		if method == nil {
+			panic(fmt.Sprintf("gateway route %s %s: unknown method %s", rt.method, rt.pattern, rt.rpc))
+		}
+		g.mux.Handle(rt.method+" "+rt.pattern, g.rpcHandler(rt, method))
+	}
+	g.mux.HandleFunc("GET /v1/openapi.json", g.serveOpenAPI)
+	g.mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, status.New(codes.NotFound, "route not found"))
+	})
+
+	return g
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// Start принимает HTTP-запросы до вызова Stop. Если Stop уже вызван,
+// Start сразу возвращает nil.
+func (g *Gateway) Start() error {
+	if err := g.httpServer.ListenAndServe(); err != nil && !stderrors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve HTTP on %s: %w", g.httpServer.Addr, err)
+	}
+	return nil
+}
+
+// Stop дожидается завершения текущих запросов и закрывает соединение с gRPC
+func (g *Gateway) Stop(ctx context.Context) error {
+	var err error
+	if g.httpServer != nil {
+		err = g.httpServer.Shutdown(ctx)
+	}
+	if g.closer != nil {
+		if cerr := g.closer.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+func (g *Gateway) rpcHandler(rt route, method protoreflect.MethodDescriptor) http.Handler {
+	fullMethod := fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name())
+	pathParams := patternParams(rt.pattern)
+	hasBody := methodHasBody(rt.method)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer.Start(ctx, rt.method+" "+rt.pattern,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", rt.method),
+				attribute.String("http.route", rt.pattern),
+			),
+		)
+		defer span.End()
+		w = &statusRecorder{ResponseWriter: w, span: span}
+		r = r.WithContext(ctx)
+
+		req, err := newMessage(method.Input())
+		if err != nil {
+			writeError(w, status.New(codes.Internal, err.Error()))
+			return
+		}
+		resp, err := newMessage(method.Output())
+		if err != nil {
+			writeError(w, status.New(codes.Internal, err.Error()))
+			return
+		}
+
+		if hasBody {
+			if err := decodeBody(r, req); err != nil {
+				writeError(w, status.New(codes.InvalidArgument, err.Error()))
+				return
+			}
+		} else if err := populateQuery(req.ProtoReflect(), r.URL.Query()); err != nil {
+			writeError(w, status.New(codes.InvalidArgument, err.Error()))
+			return
+		}
+		for _, name := range pathParams {
+			if err := setParam(req.ProtoReflect(), name, []string{r.PathValue(name)}); err != nil {
+				writeError(w, status.New(codes.InvalidArgument, err.Error()))
+				return
+			}
+		}
+
+		var header metadata.MD
+		err = g.conn.Invoke(g.outgoingContext(r), fullMethod, req, resp, grpc.Header(&header))
+		if retryAfter := header.Get("retry-after"); len(retryAfter) > 0 {
+			w.Header().Set("Retry-After", retryAfter[0])
+		}
+		if requestID := header.Get("x-request-id"); len(requestID) > 0 {
+			w.Header().Set("X-Request-Id", requestID[0])
+		}
+		if err != nil {
+			writeError(w, status.Convert(err))
+			return
+		}
+
+		writeMessage(w, http.StatusOK, resp)
+	})
+}
+
+// outgoingContext передает в gRPC токен клиента, идентификатор запроса,
+// адрес клиента и контекст трассировки. Ошибки запрашиваются статусами gRPC
+// даже в режиме совместимости, чтобы код HTTP всегда им соответствовал. Без адреса все запросы через шлюз делили бы один лимит
+// анонимных клиентов. Адрес берется из соединения, а не из заголовков
+// клиента, и подтверждается ключом прокси.
+func (g *Gateway) outgoingContext(r *http.Request) context.Context {
+	md := metadata.Pairs("x-status-errors", "true")
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		md.Set("authorization", authorization)
+	}
+	if requestID := r.Header.Get("X-Request-Id"); requestID != "" {
+		md.Set("x-request-id", requestID)
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		md.Set("x-forwarded-for", host)
+		if g.proxyKey != "" {
+			md.Set("x-proxy-key", g.proxyKey)
+		}
+	}
+	otel.GetTextMapPropagator().Inject(r.Context(), tracing.MetadataCarrier(md))
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+// statusRecorder записывает код ответа в span запроса
+type statusRecorder struct {
+	http.ResponseWriter
+	span trace.Span
+}
+
+func (w *statusRecorder) WriteHeader(code int) {
+	w.span.SetAttributes(attribute.Int("http.response.status_code", code))
+	if code >= http.StatusInternalServerError {
+		w.span.SetStatus(otelcodes.Error, http.StatusText(code))
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func decodeBody(r *http.Request, msg proto.Message) error {
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, maxBodySize))
+	if err != nil {
+		return fmt.Errorf("failed to read request body: %w", err)
+	}
+	if len(body) == 0 {
+		return nil
+	}
+	if err := unmarshalOptions.Unmarshal(body, msg); err != nil {
+		return fmt.Errorf("invalid request body: %w", err)
+	}
+	return nil
+}
+
+func writeMessage(w http.ResponseWriter, code int, msg proto.Message) {
+	body, err := marshalOptions.Marshal(msg)
+	if err != nil {
+		writeError(w, status.New(codes.Internal, "failed to encode response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, fmt.Errorf("unknown message %s: %w", desc.FullName(), err)
+	}
+	return mt.New().Interface(), nil
+}
+
+func methodHasBody(method string) bool {
+	return method == http.MethodPost || method == http.MethodPut || method == http.MethodPatch
+}
+
+// patternParams возвращает имена параметров пути, например slug для /v1/news/{slug}
+func patternParams(pattern string) []string {
+	var params []string
+	for _, segment := range strings.Split(pattern, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			params = append(params, strings.Trim(segment, "{}"))
+		}
+	}
+	return params
+}
@@ -0,0 +1,299 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "news-service/proto/news"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// fakeConn запоминает последний вызов и отвечает через invoke
+type fakeConn struct {
+	method string
+	req    proto.Message
+	md     metadata.MD
+	header metadata.MD
+	invoke func(req, resp proto.Message) error
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	c.method = method
+	c.req = args.(proto.Message)
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	for _, opt := range opts {
+		if h, ok := opt.(grpc.HeaderCallOption); ok && c.header != nil {
+			*h.HeaderAddr = c.header
+		}
+	}
+	if c.invoke == nil {
+		return nil
+	}
+	return c.invoke(c.req, reply.(proto.Message))
+}
+
+func (c *fakeConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streams are not supported")
+}
+
+func serve(g *Gateway, method, target, body string, header http.Header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeJSON(t *testing.T, rec *httptest.ResponseRecorder) map[string]interface{} {
+	t.Helper()
+	var body map[string]interface{}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatalf("Expected JSON body, got %q: %v", rec.Body.String(), err)
+	}
+	return body
+}
+
+func TestGateway_GetNews(t *testing.T) {
+	conn := &fakeConn{invoke: func(req, resp proto.Message) error {
+		resp.(*pb.GetNewsResponse).News = &pb.News{Slug: req.(*pb.GetNewsRequest).Slug, Title: "Заголовок", Version: 3}
+		return nil
+	}}
+	g := newGateway(conn)
+
+	rec := serve(g, http.MethodGet, "/v1/news/first-news?include_unpublished=true", "", nil)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if conn.method != pb.NewsService_GetNews_FullMethodName {
+		t.Errorf("Expected GetNews call, got %q", conn.method)
+	}
+	req := conn.req.(*pb.GetNewsRequest)
+	if req.Slug != "first-news" || !req.IncludeUnpublished {
+		t.Errorf("Expected slug and include_unpublished from URL, got %v", req)
+	}
+
+	news := decodeJSON(t, rec)["news"].(map[string]interface{})
+	if news["slug"] != "first-news" || news["title"] != "Заголовок" || news["version"] != "3" {
+		t.Errorf("Unexpected news in response: %v", news)
+	}
+}
+
+func TestGateway_ListQueryParameters(t *testing.T) {
+	conn := &fakeConn{}
+	g := newGateway(conn)
+
+	rec := serve(g, http.MethodGet, "/v1/news?limit=5&tags=go&tags=grpc&sortBy=SORT_FIELD_TITLE&created_after=100", "", nil)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	req := conn.req.(*pb.GetNewsListRequest)
+	if req.Limit != 5 || req.SortBy != pb.SortField_SORT_FIELD_TITLE || req.CreatedAfter != 100 {
+		t.Errorf("Unexpected request: %v", req)
+	}
+	if len(req.Tags) != 2 || req.Tags[0] != "go" || req.Tags[1] != "grpc" {
+		t.Errorf("Expected repeated tags, got %v", req.Tags)
+	}
+}
+
+func TestGateway_BodyAndMetadata(t *testing.T) {
+	conn := &fakeConn{}
+	g := newGateway(conn)
+	g.proxyKey = "proxy-key"
+
+	conn.header = metadata.Pairs("x-request-id", "req-1")
+	header := http.Header{
+		"Authorization":   {"Bearer token"},
+		"X-Request-Id":    {"req-1"},
+		"X-Forwarded-For": {"203.0.113.7"},
+		"X-Proxy-Key":     {"forged"},
+	}
+	rec := serve(g, http.MethodPatch, "/v1/news/first-news",
+		`{"slug": "ignored", "title": "Новый", "update_mask": "title", "expected_version": "2"}`, header)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	req := conn.req.(*pb.UpdateNewsRequest)
+	if req.Slug != "first-news" {
+		t.Errorf("Expected slug from path to win over body, got %q", req.Slug)
+	}
+	if req.Title != "Новый" || req.ExpectedVersion != 2 || len(req.UpdateMask.GetPaths()) != 1 {
+		t.Errorf("Unexpected request: %v", req)
+	}
+	if got := conn.md.Get("authorization"); len(got) != 1 || got[0] != "Bearer token" {
+		t.Errorf("Expected authorization to be forwarded, got %v", got)
+	}
+	if got := conn.md.Get("x-request-id"); len(got) != 1 || got[0] != "req-1" {
+		t.Errorf("Expected request id to be forwarded, got %v", got)
+	}
+	if got := rec.Header().Get("X-Request-Id"); got != "req-1" {
+		t.Errorf("Expected request id in response, got %q", got)
+	}
+	// Адрес берется из соединения: заголовки клиента не передаются дальше
+	if got := conn.md.Get("x-forwarded-for"); len(got) != 1 || got[0] != "192.0.2.1" {
+		t.Errorf("Expected client address to be forwarded, got %v", got)
+	}
+	if got := conn.md.Get("x-proxy-key"); len(got) != 1 || got[0] != "proxy-key" {
+		t.Errorf("Expected gateway proxy key, got %v", got)
+	}
+	if got := conn.md.Get("x-status-errors"); len(got) != 1 || got[0] != "true" {
+		t.Errorf("Expected gateway to ask for status errors, got %v", got)
+	}
+}
+
+func TestGateway_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name, method, target, body string
+	}{
+		{"unknown parameter", http.MethodGet, "/v1/news?pages=2", ""},
+		{"invalid number", http.MethodGet, "/v1/news?limit=ten", ""},
+		{"invalid version in path", http.MethodGet, "/v1/news/a/revisions/latest", ""},
+		{"malformed body", http.MethodPost, "/v1/news", "{"},
+		{"unknown body field", http.MethodPost, "/v1/news", `{"headline": "x"}`},
+	}
+
+	for _, tt := range tests {
+		conn := &fakeConn{}
+		rec := serve(newGateway(conn), tt.method, tt.target, tt.body, nil)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected 400, got %d", tt.name, rec.Code)
+		}
+		if conn.method != "" {
+			t.Errorf("%s: expected no RPC call, got %s", tt.name, conn.method)
+		}
+		if got := decodeJSON(t, rec)["error"].(map[string]interface{})["status"]; got != "INVALID_ARGUMENT" {
+			t.Errorf("%s: expected INVALID_ARGUMENT, got %v", tt.name, got)
+		}
+	}
+}
+
+func TestGateway_ErrorBody(t *testing.T) {
+	conn := &fakeConn{invoke: func(req, resp proto.Message) error {
+		st, _ := status.New(codes.NotFound, "news not found").WithDetails(
+			&errdetails.ErrorInfo{Reason: "NEWS_NOT_FOUND", Domain: "news-service"})
+		return st.Err()
+	}}
+
+	rec := serve(newGateway(conn), http.MethodDelete, "/v1/news/missing", "", nil)
+
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("Expected 404, got %d", rec.Code)
+	}
+	body := decodeJSON(t, rec)["error"].(map[string]interface{})
+	if body["code"] != float64(http.StatusNotFound) || body["status"] != "NOT_FOUND" || body["message"] != "news not found" {
+		t.Errorf("Unexpected error body: %v", body)
+	}
+	details := body["details"].([]interface{})
+	if len(details) != 1 || details[0].(map[string]interface{})["reason"] != "NEWS_NOT_FOUND" {
+		t.Errorf("Expected ErrorInfo detail, got %v", details)
+	}
+}
+
+func TestGateway_SlugMovedIsConflict(t *testing.T) {
+	conn := &fakeConn{invoke: func(req, resp proto.Message) error {
+		return status.Error(codes.FailedPrecondition, `News was renamed, use slug "new-slug"`)
+	}}
+
+	rec := serve(newGateway(conn), http.MethodPatch, "/v1/news/old-slug", `{"title": "x"}`, nil)
+
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("Expected 409, got %d", rec.Code)
+	}
+	if got := decodeJSON(t, rec)["error"].(map[string]interface{})["status"]; got != "FAILED_PRECONDITION" {
+		t.Errorf("Expected FAILED_PRECONDITION, got %v", got)
+	}
+}
+
+func TestGateway_RateLimitedSetsRetryAfter(t *testing.T) {
+	conn := &fakeConn{
+		header: metadata.Pairs("retry-after", "3"),
+		invoke: func(req, resp proto.Message) error {
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		},
+	}
+
+	rec := serve(newGateway(conn), http.MethodGet, "/v1/search?query=go", "", nil)
+
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Retry-After"); got != "3" {
+		t.Errorf("Expected Retry-After 3, got %q", got)
+	}
+}
+
+func TestGateway_UnknownRoute(t *testing.T) {
+	rec := serve(newGateway(&fakeConn{}), http.MethodGet, "/v2/news", "", nil)
+
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected 404, got %d", rec.Code)
+	}
+	decodeJSON(t, rec)
+}
+
+func TestRoutes_CoverAllMethods(t *testing.T) {
+	routed := make(map[string]bool, len(routes))
+	for _, rt := range routes {
+		routed[rt.rpc] = true
+	}
+
+	methods := pb.File_proto_news_news_proto.Services().ByName("NewsService").Methods()
+	for i := 0; i < methods.Len(); i++ {
+		if name := string(methods.Get(i).Name()); !routed[name] {
+			t.Errorf("Method %s has no HTTP route", name)
+		}
+	}
+}
+
+func TestGateway_OpenAPI(t *testing.T) {
+	rec := serve(newGateway(&fakeConn{}), http.MethodGet, "/v1/openapi.json", "", nil)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected 200, got %d", rec.Code)
+	}
+	doc := decodeJSON(t, rec)
+	paths := doc["paths"].(map[string]interface{})
+	item, ok := paths["/v1/news/{slug}"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Expected /v1/news/{slug} in paths, got %v", paths)
+	}
+	for _, method := range []string{"get", "patch", "delete"} {
+		if _, ok := item[method]; !ok {
+			t.Errorf("Expected %s operation for /v1/news/{slug}", method)
+		}
+	}
+	schemas := doc["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+	if _, ok := schemas["News"]; !ok {
+		t.Error("Expected News schema in components")
+	}
+}
+
+func TestGateway_StopBeforeStart(t *testing.T) {
+	g, err := NewGateway("127.0.0.1:0", "localhost:1", "")
+	if err != nil {
+		t.Fatalf("Failed to create gateway: %v", err)
+	}
+
+	if err := g.Stop(context.Background()); err != nil {
+		t.Fatalf("Failed to stop gateway: %v", err)
+	}
+	// Остановленный шлюз не начинает принимать запросы
+	if err := g.Start(); err != nil {
+		t.Errorf("Expected Start after Stop to return nil, got %v", err)
+	}
+}
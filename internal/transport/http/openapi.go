@@ -0,0 +1,214 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	pb "news-service/proto/news"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Описание API в формате OpenAPI 3 строится по таблице маршрутов и
+// дескрипторам proto, поэтому всегда совпадает с тем, что умеет шлюз.
+
+type object = map[string]interface{}
+
+var (
+	openAPIOnce sync.Once
+	openAPIDoc  []byte
+)
+
+func (g *Gateway) serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	openAPIOnce.Do(func() {
+		openAPIDoc, _ = json.MarshalIndent(buildOpenAPI(), "", "  ")
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPIDoc)
+}
+
+func buildOpenAPI() object {
+	service := pb.File_proto_news_news_proto.Services().ByName("NewsService")
+	schemas := object{
+		"Error": object{
+			"type": "object",
+			"properties": object{
+				"error": object{
+					"type": "object",
+					"properties": object{
+						"code":    object{"type": "integer", "description": "HTTP status code"},
+						"status":  object{"type": "string", "description": "gRPC status code name"},
+						"message": object{"type": "string"},
+						"details": object{"type": "array", "items": object{"type": "object"}},
+					},
+				},
+			},
+		},
+	}
+
+	paths := object{}
+	for _, rt := range routes {
+		method := service.Methods().ByName(protoreflect.Name(rt.rpc))
+		params := patternParams(rt.pattern)
+
+		operation := object{
+			"operationId": rt.rpc,
+			"tags":        []string{string(service.Name())},
+			"parameters":  operationParameters(method.Input(), params, methodHasBody(rt.method)),
+			"responses": object{
+				"200": object{
+					"description": "OK",
+					"content":     jsonContent(schemaRef(method.Output(), schemas)),
+				},
+				"default": object{
+					"description": "Error",
+					"content":     jsonContent(object{"$ref": "#/components/schemas/Error"}),
+				},
+			},
+		}
+		if methodHasBody(rt.method) {
+			operation["requestBody"] = object{
+				"content": jsonContent(schemaRef(method.Input(), schemas)),
+			}
+		}
+
+		item, ok := paths[rt.pattern].(object)
+		if !ok {
+			item = object{}
+			paths[rt.pattern] = item
+		}
+		item[strings.ToLower(rt.method)] = operation
+	}
+
+	return object{
+		"openapi": "3.0.3",
+		"info": object{
+			"title":   "News Service API",
+			"version": "v1",
+		},
+		"paths": paths,
+		"components": object{
+			"schemas": schemas,
+			"securitySchemes": object{
+				"bearerAuth": object{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+		"security": []object{{"bearerAuth": []string{}}},
+	}
+}
+
+// operationParameters описывает параметры пути и, для запросов без тела,
+// параметры строки запроса
+func operationParameters(input protoreflect.MessageDescriptor, pathParams []string, hasBody bool) []object {
+	parameters := []object{}
+	inPath := make(map[string]bool, len(pathParams))
+	for _, name := range pathParams {
+		inPath[name] = true
+		fd := input.Fields().ByName(protoreflect.Name(name))
+		parameters = append(parameters, object{
+			"name":     name,
+			"in":       "path",
+			"required": true,
+			"schema":   fieldSchema(fd, nil),
+		})
+	}
+	if hasBody {
+		return parameters
+	}
+
+	fields := input.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if inPath[string(fd.Name())] || !isScalar(fd) {
+			continue
+		}
+		parameter := object{
+			"name":   string(fd.Name()),
+			"in":     "query",
+			"schema": fieldSchema(fd, nil),
+		}
+		if fd.IsList() {
+			parameter["explode"] = true
+		}
+		parameters = append(parameters, parameter)
+	}
+	return parameters
+}
+
+func jsonContent(schema object) object {
+	return object{"application/json": object{"schema": schema}}
+}
+
+// schemaRef добавляет схему сообщения (и вложенных сообщений) в schemas
+// и возвращает ссылку на нее
+func schemaRef(desc protoreflect.MessageDescriptor, schemas object) object {
+	name := string(desc.Name())
+	ref := object{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+
+	properties := object{}
+	schema := object{"type": "object", "properties": properties}
+	schemas[name] = schema
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		property := fieldSchema(fd, schemas)
+		if opts, ok := fd.Options().(*descriptorpb.FieldOptions); ok && opts.GetDeprecated() {
+			property["deprecated"] = true
+		}
+		properties[string(fd.Name())] = property
+	}
+	return ref
+}
+
+func fieldSchema(fd protoreflect.FieldDescriptor, schemas object) object {
+	schema := kindSchema(fd, schemas)
+	if fd.IsList() {
+		return object{"type": "array", "items": schema}
+	}
+	return schema
+}
+
+// kindSchema следует правилам protojson: 64-битные целые кодируются строками,
+// перечисления - именами значений, FieldMask - строкой путей через запятую.
+func kindSchema(fd protoreflect.FieldDescriptor, schemas object) object {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return object{"type": "string"}
+	case protoreflect.BoolKind:
+		return object{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return object{"type": "integer", "format": "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return object{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return object{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return object{"type": "number", "format": "double"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, 0, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names = append(names, string(values.Get(i).Name()))
+		}
+		return object{"type": "string", "enum": names}
+	case protoreflect.MessageKind:
+		if fd.Message().FullName() == "google.protobuf.FieldMask" {
+			return object{"type": "string", "example": "title,content"}
+		}
+		if schemas == nil {
+			return object{"type": "object"}
+		}
+		return schemaRef(fd.Message(), schemas)
+	default:
+		return object{}
+	}
+}
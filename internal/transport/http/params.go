@@ -0,0 +1,99 @@
+package http
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// populateQuery заполняет поля запроса из строки запроса. Имена параметров
+// совпадают с именами полей в proto (page_token) или в JSON (pageToken),
+// повторяющиеся поля передаются несколькими параметрами: ?tags=go&tags=grpc.
+func populateQuery(msg protoreflect.Message, query url.Values) error {
+	for name, values := range query {
+		if err := setParam(msg, name, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setParam(msg protoreflect.Message, name string, values []string) error {
+	fields := msg.Descriptor().Fields()
+	fd := fields.ByName(protoreflect.Name(name))
+	if fd == nil {
+		fd = fields.ByJSONName(name)
+	}
+	if fd == nil || !isScalar(fd) {
+		return fmt.Errorf("unknown parameter %q", name)
+	}
+
+	if fd.IsList() {
+		list := msg.Mutable(fd).List()
+		for _, value := range values {
+			v, err := parseScalar(fd, value)
+			if err != nil {
+				return fmt.Errorf("invalid value for %q: %w", name, err)
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+
+	if len(values) != 1 {
+		return fmt.Errorf("parameter %q must be set once", name)
+	}
+	v, err := parseScalar(fd, values[0])
+	if err != nil {
+		return fmt.Errorf("invalid value for %q: %w", name, err)
+	}
+	msg.Set(fd, v)
+	return nil
+}
+
+// isScalar сообщает, можно ли передать поле параметром: вложенные сообщения
+// и словари принимаются только в JSON-теле.
+func isScalar(fd protoreflect.FieldDescriptor) bool {
+	return !fd.IsMap() && fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind
+}
+
+func parseScalar(fd protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(value)
+		return protoreflect.ValueOfBool(v), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(value, 10, 32)
+		return protoreflect.ValueOfInt32(int32(v)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(value, 10, 64)
+		return protoreflect.ValueOfInt64(v), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(value, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(v)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(value, 10, 64)
+		return protoreflect.ValueOfUint64(v), err
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(value, 32)
+		return protoreflect.ValueOfFloat32(float32(v)), err
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(value, 64)
+		return protoreflect.ValueOfFloat64(v), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(value)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(protoreflect.EnumNumber(n)) == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown enum value %q", value)
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
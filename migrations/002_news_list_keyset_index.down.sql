@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_news_created_at;
+CREATE INDEX idx_news_created_at ON news(created_at DESC);
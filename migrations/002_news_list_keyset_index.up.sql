@@ -0,0 +1,3 @@
+-- Составной индекс для keyset-пагинации по (created_at, slug)
+DROP INDEX IF EXISTS idx_news_created_at;
+CREATE INDEX idx_news_created_at ON news(created_at DESC, slug DESC);
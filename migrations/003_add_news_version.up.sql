@@ -0,0 +1,2 @@
+-- Версия новости для оптимистичной блокировки: увеличивается при каждом изменении
+ALTER TABLE news ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_news_slug_aliases_slug;
+DROP TABLE IF EXISTS news_slug_aliases;
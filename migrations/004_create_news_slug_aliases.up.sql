@@ -0,0 +1,9 @@
+-- Старые slug переименованных новостей: GetNews по ним перенаправляет на актуальную новость.
+-- ON UPDATE CASCADE переносит алиасы при повторном переименовании.
+CREATE TABLE IF NOT EXISTS news_slug_aliases (
+    old_slug VARCHAR(255) PRIMARY KEY,
+    slug VARCHAR(255) NOT NULL REFERENCES news(slug) ON UPDATE CASCADE ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_news_slug_aliases_slug ON news_slug_aliases(slug);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_news_deleted_at;
+DELETE FROM news WHERE deleted_at IS NOT NULL;
+ALTER TABLE news DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,5 @@
+-- Мягкое удаление: удаленные новости хранятся в корзине до очистки
+ALTER TABLE news ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP NULL;
+
+-- Индекс для корзины и фоновой очистки
+CREATE INDEX idx_news_deleted_at ON news(deleted_at DESC) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,10 @@
+-- Полнотекстовый поиск по заголовку (вес A) и содержимому (вес B)
+-- с русской и английской конфигурациями
+ALTER TABLE news ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(content, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(content, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_news_search_vector ON news USING GIN (search_vector);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_news_title_prefix;
+DROP INDEX IF EXISTS idx_news_title;
+DROP INDEX IF EXISTS idx_news_updated_at;
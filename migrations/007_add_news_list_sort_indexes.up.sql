@@ -0,0 +1,4 @@
+-- Индексы для сортировки списка по updated_at и title и для фильтра по началу заголовка
+CREATE INDEX idx_news_updated_at ON news(updated_at DESC, slug DESC);
+CREATE INDEX idx_news_title ON news(title, slug);
+CREATE INDEX idx_news_title_prefix ON news(lower(title) text_pattern_ops);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_news_tags_tag_id;
+DROP TABLE IF EXISTS news_tags;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,16 @@
+-- Теги (рубрики) новостей
+CREATE TABLE IF NOT EXISTS tags (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Связь новостей и тегов; slug переносится при переименовании новости
+CREATE TABLE IF NOT EXISTS news_tags (
+    news_slug VARCHAR(255) NOT NULL REFERENCES news(slug) ON UPDATE CASCADE ON DELETE CASCADE,
+    tag_id BIGINT NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (news_slug, tag_id)
+);
+
+-- Индекс для фильтра списка по тегам и подсчета новостей по тегу
+CREATE INDEX idx_news_tags_tag_id ON news_tags(tag_id);
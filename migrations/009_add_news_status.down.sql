@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_news_scheduled;
+ALTER TABLE news DROP COLUMN IF EXISTS publish_at;
+ALTER TABLE news DROP COLUMN IF EXISTS status;
@@ -0,0 +1,11 @@
+-- Статус публикации; существующие новости уже опубликованы
+ALTER TABLE news ADD COLUMN IF NOT EXISTS status VARCHAR(16) NOT NULL DEFAULT 'published'
+    CHECK (status IN ('draft', 'scheduled', 'published', 'archived'));
+
+-- Время публикации: у черновиков может быть пустым. При повторном запуске
+-- заполняются только опубликованные новости без времени публикации.
+ALTER TABLE news ADD COLUMN IF NOT EXISTS publish_at TIMESTAMP;
+UPDATE news SET publish_at = created_at WHERE publish_at IS NULL AND status = 'published';
+
+-- Индекс для планировщика, публикующего отложенные новости
+CREATE INDEX IF NOT EXISTS idx_news_scheduled ON news(publish_at) WHERE status = 'scheduled';
@@ -0,0 +1,17 @@
+-- История изменений новостей: снимок после каждого изменения версии
+CREATE TABLE IF NOT EXISTS news_revisions (
+    id BIGSERIAL PRIMARY KEY,
+    news_slug VARCHAR(255) NOT NULL REFERENCES news(slug) ON UPDATE CASCADE ON DELETE CASCADE,
+    -- Версия новости, которую получила новость этим изменением
+    version BIGINT NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    content TEXT NOT NULL,
+    author VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (news_slug, version)
+);
+
+-- Текущее состояние существующих новостей становится их первой ревизией
+INSERT INTO news_revisions (news_slug, version, title, content, created_at)
+SELECT slug, version, title, content, updated_at FROM news
+ON CONFLICT (news_slug, version) DO NOTHING;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_news_updated_by;
+DROP INDEX IF EXISTS idx_news_created_by;
+ALTER TABLE news DROP COLUMN IF EXISTS updated_by;
+ALTER TABLE news DROP COLUMN IF EXISTS created_by;
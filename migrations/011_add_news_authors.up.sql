@@ -0,0 +1,16 @@
+-- Авторы новости: кто создал и кто последним изменил
+ALTER TABLE news ADD COLUMN IF NOT EXISTS created_by VARCHAR(255) NOT NULL DEFAULT '';
+ALTER TABLE news ADD COLUMN IF NOT EXISTS updated_by VARCHAR(255) NOT NULL DEFAULT '';
+
+-- Заполняем по истории изменений; при повторном запуске уже заполненные
+-- авторы не перезаписываются
+UPDATE news n SET
+    created_by = COALESCE((SELECT author FROM news_revisions r
+        WHERE r.news_slug = n.slug ORDER BY version LIMIT 1), ''),
+    updated_by = COALESCE((SELECT author FROM news_revisions r
+        WHERE r.news_slug = n.slug ORDER BY version DESC LIMIT 1), '')
+WHERE n.created_by = '' AND n.updated_by = '';
+
+-- Индексы для фильтров "мои новости"
+CREATE INDEX IF NOT EXISTS idx_news_created_by ON news(created_by);
+CREATE INDEX IF NOT EXISTS idx_news_updated_by ON news(updated_by);
@@ -1,32 +1,156 @@
 package database
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
 	"news-service/internal/config"
 
 	_ "github.com/lib/pq"
 )
 
-func NewPostgresConnection(cfg *config.Config) (*sql.DB, error) {
-	dsn := fmt.Sprintf(
-		"host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Database.Host,
-		cfg.Database.Port,
-		cfg.Database.User,
-		cfg.Database.Password,
-		cfg.Database.DBName,
-		cfg.Database.SSLMode,
-	)
+// maxConnectBackoff ограничивает паузу между попытками подключения
+const maxConnectBackoff = 10 * time.Second
+
+// NewPostgresConnection открывает пул соединений с настройками из cfg и
+// проверяет подключение. Пока БД недоступна (например, контейнер еще
+// запускается), проверка повторяется с растущей паузой.
+func NewPostgresConnection(ctx context.Context, cfg *config.Config) (*sql.DB, error) {
+	dsn, err := DSN(cfg)
+	if err != nil {
+		return nil, err
+	}
 
 	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
-	if err := db.Ping(); err != nil {
-		return nil, fmt.Errorf("failed to ping database: %w", err)
+	configurePool(db, cfg)
+
+	if err := pingWithRetry(ctx, db, cfg.Database.ConnectAttempts, cfg.Database.ConnectBackoff); err != nil {
+		db.Close()
+		return nil, err
 	}
 
 	return db, nil
 }
+
+// NewReplicaConnections открывает пулы соединений к репликам из
+// cfg.Database.ReplicaURLs. Подключение не проверяется: недоступная при запуске
+// реплика просто не попадет в ротацию, пока не поднимется.
+func NewReplicaConnections(cfg *config.Config) ([]*sql.DB, error) {
+	dbs := make([]*sql.DB, 0, len(cfg.Database.ReplicaURLs))
+	for i, url := range cfg.Database.ReplicaURLs {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+		db, err := sql.Open("postgres", url)
+		if err != nil {
+			for _, opened := range dbs {
+				opened.Close()
+			}
+			return nil, fmt.Errorf("failed to open replica %d: %w", i, err)
+		}
+		configurePool(db, cfg)
+		dbs = append(dbs, db)
+	}
+	return dbs, nil
+}
+
+func configurePool(db *sql.DB, cfg *config.Config) {
+	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(cfg.Database.ConnMaxIdleTime)
+}
+
+type dsnParam struct {
+	key, value string
+}
+
+// DSN строит строку подключения в формате key=value. Если задан
+// cfg.Database.URL, он возвращается без изменений.
+func DSN(cfg *config.Config) (string, error) {
+	c := cfg.Database
+	if c.URL != "" {
+		return c.URL, nil
+	}
+	if (c.SSLCert == "") != (c.SSLKey == "") {
+		return "", fmt.Errorf("database sslcert and sslkey must be set together")
+	}
+
+	params := []dsnParam{
+		{"host", c.Host},
+		{"port", strconv.Itoa(c.Port)},
+		{"user", c.User},
+		{"password", c.Password},
+		{"dbname", c.DBName},
+		{"sslmode", c.SSLMode},
+		{"sslrootcert", c.SSLRootCert},
+		{"sslcert", c.SSLCert},
+		{"sslkey", c.SSLKey},
+		{"application_name", c.ApplicationName},
+	}
+	if c.ConnectTimeout > 0 {
+		// libpq принимает целые секунды; округляем вверх, чтобы 500ms не превратились в "без ограничения"
+		params = append(params, dsnParam{"connect_timeout", strconv.Itoa(int(math.Ceil(c.ConnectTimeout.Seconds())))})
+	}
+	if c.StatementTimeout > 0 {
+		// Неизвестные драйверу параметры передаются серверу как параметры сессии
+		params = append(params, dsnParam{"statement_timeout", strconv.FormatInt(c.StatementTimeout.Milliseconds(), 10)})
+	}
+
+	parts := make([]string, 0, len(params))
+	for _, p := range params {
+		if p.value == "" {
+			continue
+		}
+		parts = append(parts, p.key+"="+quoteValue(p.value))
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// quoteValue экранирует значение по правилам libpq: пустые значения и
+// значения с пробелами берутся в одинарные кавычки, \ и ' экранируются
+func quoteValue(value string) string {
+	if value != "" && !strings.ContainsAny(value, ` '\`+"\t\n") {
+		return value
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `'`, `\'`)
+	return "'" + value + "'"
+}
+
+func pingWithRetry(ctx context.Context, db *sql.DB, attempts int, backoff time.Duration) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = db.PingContext(ctx); err == nil {
+			return nil
+		}
+		if attempt == attempts || ctx.Err() != nil {
+			break
+		}
+
+		slog.WarnContext(ctx, "Database is not ready, retrying",
+			"attempt", attempt, "attempts", attempts, "delay", backoff, "error", err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return fmt.Errorf("failed to ping database: %w", ctx.Err())
+		}
+		backoff = min(backoff*2, maxConnectBackoff)
+	}
+	return fmt.Errorf("failed to ping database after %d attempts: %w", attempts, err)
+}
@@ -0,0 +1,79 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"news-service/internal/config"
+)
+
+func testConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.Database.Host = "localhost"
+	cfg.Database.Port = 5432
+	cfg.Database.User = "postgres"
+	cfg.Database.Password = "password"
+	cfg.Database.DBName = "news_db"
+	cfg.Database.SSLMode = "disable"
+	return cfg
+}
+
+func TestDSN(t *testing.T) {
+	cfg := testConfig()
+	cfg.Database.ApplicationName = "news-service"
+	cfg.Database.ConnectTimeout = 1500 * time.Millisecond
+	cfg.Database.StatementTimeout = 30 * time.Second
+
+	dsn, err := DSN(cfg)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := "host=localhost port=5432 user=postgres password=password dbname=news_db sslmode=disable " +
+		"application_name=news-service connect_timeout=2 statement_timeout=30000"
+	if dsn != want {
+		t.Errorf("Expected %q, got %q", want, dsn)
+	}
+}
+
+func TestDSN_TLSAndQuoting(t *testing.T) {
+	cfg := testConfig()
+	cfg.Database.Password = `it's a \secret`
+	cfg.Database.SSLMode = "verify-full"
+	cfg.Database.SSLRootCert = "/etc/certs/root ca.crt"
+	cfg.Database.SSLCert = "/etc/certs/client.crt"
+	cfg.Database.SSLKey = "/etc/certs/client.key"
+
+	dsn, err := DSN(cfg)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := `host=localhost port=5432 user=postgres password='it\'s a \\secret' dbname=news_db sslmode=verify-full ` +
+		`sslrootcert='/etc/certs/root ca.crt' sslcert=/etc/certs/client.crt sslkey=/etc/certs/client.key`
+	if dsn != want {
+		t.Errorf("Expected %q, got %q", want, dsn)
+	}
+}
+
+func TestDSN_URLOverride(t *testing.T) {
+	cfg := testConfig()
+	cfg.Database.URL = "postgres://app@db.internal:5432/news?sslmode=require"
+
+	dsn, err := DSN(cfg)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if dsn != cfg.Database.URL {
+		t.Errorf("Expected URL to be used as is, got %q", dsn)
+	}
+}
+
+func TestDSN_ClientCertWithoutKey(t *testing.T) {
+	cfg := testConfig()
+	cfg.Database.SSLCert = "/etc/certs/client.crt"
+
+	if _, err := DSN(cfg); err == nil {
+		t.Error("Expected error for sslcert without sslkey")
+	}
+}
@@ -0,0 +1,226 @@
+package diff
+
+import "strings"
+
+// Op - вид изменения строки
+type Op int
+
+const (
+	Equal Op = iota
+	Insert
+	Delete
+)
+
+// Line - строка результата сравнения
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines сравнивает тексты построчно алгоритмом Майерса с поиском средней
+// змейки: результат содержит минимальное число изменений, а память растет
+// линейно от числа строк. Время - O((N+M)·D), где D - число изменений,
+// поэтому вызывающий должен ограничивать размер текстов (см. CountLines).
+// Удаленные строки идут перед добавленными на том же месте.
+func Lines(a, b string) []Line {
+	x, y := split(a), split(b)
+
+	// Сравниваем номера строк вместо самих строк
+	ids := make(map[string]int)
+	d := &differ{
+		x:     intern(x, ids),
+		y:     intern(y, ids),
+		textX: x,
+		textY: y,
+		lines: make([]Line, 0, max(len(x), len(y))),
+	}
+	d.compare(0, len(x), 0, len(y))
+	return deletesFirst(d.lines)
+}
+
+// CountLines возвращает число строк, которое Lines увидит в тексте
+func CountLines(s string) int {
+	if s == "" {
+		return 0
+	}
+	return strings.Count(strings.TrimSuffix(s, "\n"), "\n") + 1
+}
+
+type differ struct {
+	x, y         []int
+	textX, textY []string
+	lines        []Line
+}
+
+// compare добавляет в d.lines изменения, превращающие x[x0:x1] в y[y0:y1]
+func (d *differ) compare(x0, x1, y0, y1 int) {
+	// Общие начало и конец не участвуют в поиске
+	for x0 < x1 && y0 < y1 && d.x[x0] == d.y[y0] {
+		d.lines = append(d.lines, Line{Op: Equal, Text: d.textX[x0]})
+		x0++
+		y0++
+	}
+	suffix := 0
+	for x0 < x1-suffix && y0 < y1-suffix && d.x[x1-suffix-1] == d.y[y1-suffix-1] {
+		suffix++
+	}
+	x1, y1 = x1-suffix, y1-suffix
+
+	switch {
+	case x0 == x1:
+		d.insert(y0, y1)
+	case y0 == y1:
+		d.delete(x0, x1)
+	default:
+		if xm, ym, ok := d.middleSnake(x0, x1, y0, y1); ok {
+			d.compare(x0, xm, y0, ym)
+			d.compare(xm, x1, ym, y1)
+		} else {
+			d.delete(x0, x1)
+			d.insert(y0, y1)
+		}
+	}
+
+	for i := x1; i < x1+suffix; i++ {
+		d.lines = append(d.lines, Line{Op: Equal, Text: d.textX[i]})
+	}
+}
+
+func (d *differ) insert(y0, y1 int) {
+	for j := y0; j < y1; j++ {
+		d.lines = append(d.lines, Line{Op: Insert, Text: d.textY[j]})
+	}
+}
+
+func (d *differ) delete(x0, x1 int) {
+	for i := x0; i < x1; i++ {
+		d.lines = append(d.lines, Line{Op: Delete, Text: d.textX[i]})
+	}
+}
+
+// middleSnake ищет точку, через которую проходит кратчайший путь правок,
+// одновременно с начала и с конца. ok == false, если общих строк нет.
+func (d *differ) middleSnake(x0, x1, y0, y1 int) (xm, ym int, ok bool) {
+	n, m := x1-x0, y1-y0
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	size := 2*maxD + 2
+
+	// forward[k] и backward[k] - дальше всего продвинувшийся x на диагонали k
+	// при поиске с начала и с конца; -1 - диагональ еще не достигнута
+	forward := make([]int, size)
+	backward := make([]int, size)
+	for i := range forward {
+		forward[i] = -1
+		backward[i] = -1
+	}
+	forward[offset+1] = 0
+	backward[offset+1] = 0
+
+	delta := n - m
+	// При нечетной разнице длин пути встречаются на шаге поиска с начала
+	odd := delta%2 != 0
+	var fStart, fEnd, bStart, bEnd int
+
+	for step := 0; step < maxD; step++ {
+		for k := -step + fStart; k <= step-fEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -step || (k != step && forward[i-1] < forward[i+1]) {
+				x = forward[i+1]
+			} else {
+				x = forward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.x[x0+x] == d.y[y0+y] {
+				x++
+				y++
+			}
+			forward[i] = x
+
+			switch {
+			case x > n:
+				fEnd += 2
+			case y > m:
+				fStart += 2
+			case odd:
+				if j := offset + delta - k; j >= 0 && j < size && backward[j] != -1 && x >= n-backward[j] {
+					return x0 + x, y0 + y, true
+				}
+			}
+		}
+
+		for k := -step + bStart; k <= step-bEnd; k += 2 {
+			i := offset + k
+			var x int
+			if k == -step || (k != step && backward[i-1] < backward[i+1]) {
+				x = backward[i+1]
+			} else {
+				x = backward[i-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && d.x[x1-x-1] == d.y[y1-y-1] {
+				x++
+				y++
+			}
+			backward[i] = x
+
+			switch {
+			case x > n:
+				bEnd += 2
+			case y > m:
+				bStart += 2
+			case !odd:
+				if j := offset + delta - k; j >= 0 && j < size && forward[j] != -1 {
+					fx := forward[j]
+					fy := fx - (j - offset)
+					if fx >= n-x {
+						return x0 + fx, y0 + fy, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
+
+// deletesFirst переставляет удаления перед добавлениями внутри каждого
+// участка изменений; число изменений при этом не меняется
+func deletesFirst(lines []Line) []Line {
+	var inserts []Line
+	out := make([]Line, 0, len(lines))
+	for _, line := range lines {
+		switch line.Op {
+		case Insert:
+			inserts = append(inserts, line)
+		case Delete:
+			out = append(out, line)
+		default:
+			out = append(out, inserts...)
+			inserts = inserts[:0]
+			out = append(out, line)
+		}
+	}
+	return append(out, inserts...)
+}
+
+func intern(lines []string, ids map[string]int) []int {
+	out := make([]int, len(lines))
+	for i, line := range lines {
+		id, ok := ids[line]
+		if !ok {
+			id = len(ids)
+			ids[line] = id
+		}
+		out[i] = id
+	}
+	return out
+}
+
+// split делит текст на строки; пустой текст не содержит строк
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
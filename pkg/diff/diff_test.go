@@ -0,0 +1,120 @@
+package diff
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected []Line
+	}{
+		{"equal", "a\nb", "a\nb", []Line{{Equal, "a"}, {Equal, "b"}}},
+		{"insert", "a\nc", "a\nb\nc", []Line{{Equal, "a"}, {Insert, "b"}, {Equal, "c"}}},
+		{"delete", "a\nb\nc", "a\nc", []Line{{Equal, "a"}, {Delete, "b"}, {Equal, "c"}}},
+		{"replace", "a\nb\nc", "a\nx\nc", []Line{{Equal, "a"}, {Delete, "b"}, {Insert, "x"}, {Equal, "c"}}},
+		{"replace block", "a\nb\nc\nd", "a\nx\ny\nd", []Line{{Equal, "a"}, {Delete, "b"}, {Delete, "c"}, {Insert, "x"}, {Insert, "y"}, {Equal, "d"}}},
+		{"from empty", "", "a", []Line{{Insert, "a"}}},
+		{"to empty", "a", "", []Line{{Delete, "a"}}},
+		{"trailing newline", "a\n", "a", []Line{{Equal, "a"}}},
+	}
+
+	for _, tt := range tests {
+		got := Lines(tt.a, tt.b)
+		if !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("%s: Lines(%q, %q) = %v, expected %v", tt.name, tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+// lcsLength - эталонная длина общей подпоследовательности за O(N·M)
+func lcsLength(x, y []string) int {
+	prev := make([]int, len(y)+1)
+	cur := make([]int, len(y)+1)
+	for i := len(x) - 1; i >= 0; i-- {
+		for j := len(y) - 1; j >= 0; j-- {
+			if x[i] == y[j] {
+				cur[j] = prev[j+1] + 1
+			} else {
+				cur[j] = max(prev[j], cur[j+1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[0]
+}
+
+// checkDiff проверяет, что из результата восстанавливаются оба текста,
+// а число общих строк максимально
+func checkDiff(t *testing.T, a, b string) {
+	t.Helper()
+
+	lines := Lines(a, b)
+	var from, to []string
+	equal := 0
+	for _, line := range lines {
+		switch line.Op {
+		case Equal:
+			from = append(from, line.Text)
+			to = append(to, line.Text)
+			equal++
+		case Delete:
+			from = append(from, line.Text)
+		case Insert:
+			to = append(to, line.Text)
+		}
+	}
+	if !reflect.DeepEqual(from, split(a)) || !reflect.DeepEqual(to, split(b)) {
+		t.Fatalf("Lines(%q, %q) = %v does not reproduce the texts", a, b, lines)
+	}
+	if want := lcsLength(split(a), split(b)); equal != want {
+		t.Fatalf("Lines(%q, %q) kept %d common lines, expected %d", a, b, equal, want)
+	}
+}
+
+func TestLines_MinimalOnRandomTexts(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	text := func() string {
+		lines := make([]string, rng.Intn(30))
+		for i := range lines {
+			lines[i] = string(rune('a' + rng.Intn(4)))
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	for i := 0; i < 2000; i++ {
+		checkDiff(t, text(), text())
+	}
+}
+
+func TestLines_LargeTexts(t *testing.T) {
+	a := make([]string, 5000)
+	b := make([]string, 5000)
+	for i := range a {
+		a[i] = "line " + strings.Repeat("x", i%7) + string(rune('a'+i%26))
+		b[i] = "other " + string(rune('a'+i%26))
+	}
+
+	// Полностью разные тексты - худший случай для числа изменений
+	start := time.Now()
+	lines := Lines(strings.Join(a, "\n"), strings.Join(b, "\n"))
+	if len(lines) != len(a)+len(b) {
+		t.Errorf("Expected %d changed lines, got %d", len(a)+len(b), len(lines))
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected large diff to finish quickly, took %s", elapsed)
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	for _, s := range []string{"", "a", "a\n", "a\nb", "a\n\nb\n"} {
+		if got, want := CountLines(s), len(split(s)); got != want {
+			t.Errorf("CountLines(%q) = %d, expected %d", s, got, want)
+		}
+	}
+}
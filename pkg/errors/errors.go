@@ -1,6 +1,9 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrNewsNotFound      = errors.New("news not found")
@@ -9,4 +12,21 @@ var (
 	ErrInvalidTitle      = errors.New("invalid title")
 	ErrInvalidContent    = errors.New("invalid content")
 	ErrInvalidPagination = errors.New("invalid pagination parameters")
+	ErrConflict          = errors.New("news was modified concurrently")
+	ErrRevisionNotFound  = errors.New("revision not found")
+	ErrUnauthenticated   = errors.New("authentication required")
+	ErrPermissionDenied  = errors.New("permission denied")
+	ErrRateLimited       = errors.New("rate limit exceeded")
 )
+
+// SlugMovedError возвращается изменяющими методами, если новость запрошена по
+// старому slug: изменения принимаются только по актуальному slug, чтобы клиент
+// не правил новость, думая, что она все еще называется по-старому.
+type SlugMovedError struct {
+	// Slug - актуальный slug новости
+	Slug string
+}
+
+func (e *SlugMovedError) Error() string {
+	return fmt.Sprintf("news was renamed, use slug %q", e.Slug)
+}
@@ -0,0 +1,81 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Правила валидации, нарушение которых описывает FieldViolation
+const (
+	RuleRequired  = "required"
+	RuleMaxLength = "max_length"
+	RuleMinValue  = "min_value"
+	RuleMaxValue  = "max_value"
+	RulePattern   = "pattern"
+	RuleFormat    = "format"
+	// RuleAllowedValues - значение не входит в список допустимых
+	RuleAllowedValues = "allowed_values"
+)
+
+// FieldViolation описывает нарушение одного правила для одного поля
+type FieldViolation struct {
+	Field string
+	Rule  string
+	// Limit - граница правила (максимальная длина, минимальное значение), 0 если неприменимо
+	Limit       int
+	Description string
+}
+
+// ValidationError собирает все нарушения, найденные при проверке запроса
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// Add добавляет нарушение
+func (e *ValidationError) Add(field, rule string, limit int, description string) {
+	e.Violations = append(e.Violations, FieldViolation{
+		Field:       field,
+		Rule:        rule,
+		Limit:       limit,
+		Description: description,
+	})
+}
+
+// Err возвращает nil, если нарушений нет, иначе саму ошибку
+func (e *ValidationError) Err() error {
+	if len(e.Violations) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		descriptions[i] = v.Description
+	}
+	return fmt.Sprintf("validation failed: %s", strings.Join(descriptions, "; "))
+}
+
+// Is позволяет сравнивать ValidationError с ошибками-сентинелами, которые
+// сервис возвращал до появления ValidationError, например
+// errors.Is(err, ErrInvalidTitle) для нарушения в поле title. Для остальных
+// полей нарушения проверяются по Violations.
+func (e *ValidationError) Is(target error) bool {
+	for _, v := range e.Violations {
+		if fieldErrors[v.Field] == target {
+			return true
+		}
+	}
+	return false
+}
+
+var fieldErrors = map[string]error{
+	"slug":       ErrInvalidSlug,
+	"new_slug":   ErrInvalidSlug,
+	"title":      ErrInvalidTitle,
+	"content":    ErrInvalidContent,
+	"page":       ErrInvalidPagination,
+	"limit":      ErrInvalidPagination,
+	"page_token": ErrInvalidPagination,
+}
@@ -0,0 +1,75 @@
+package slugify
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// MaxLength - максимальная длина slug, совпадает с колонкой news.slug
+const MaxLength = 255
+
+// cyrillic - транслитерация русского алфавита в латиницу
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "",
+	'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+}
+
+// Make строит slug из произвольного текста: переводит кириллицу в латиницу,
+// убирает диакритику, приводит к нижнему регистру и заменяет все остальные
+// символы дефисами. Результат не длиннее MaxLength и может быть пустым.
+func Make(text string) string {
+	var b strings.Builder
+	// pendingDash откладывает дефис до следующего значимого символа,
+	// чтобы не было повторных дефисов и дефисов по краям
+	pendingDash := false
+
+	write := func(s string) {
+		if s == "" {
+			return
+		}
+		if pendingDash && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		pendingDash = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(text) {
+		// Кириллицу переводим до нормализации: NFD разложил бы "ё" и "й"
+		if latin, ok := cyrillic[r]; ok {
+			write(latin)
+			continue
+		}
+
+		// NFD раскладывает "é" на "e" и комбинируемый знак, который отбрасывается
+		for _, d := range norm.NFD.String(string(r)) {
+			switch {
+			case d >= 'a' && d <= 'z', d >= '0' && d <= '9':
+				write(string(d))
+			case unicode.Is(unicode.Mn, d):
+			default:
+				pendingDash = true
+			}
+		}
+	}
+
+	return Truncate(b.String(), MaxLength)
+}
+
+// Truncate обрезает slug до maxLength, по возможности по границе слова
+func Truncate(s string, maxLength int) string {
+	if len(s) <= maxLength {
+		return s
+	}
+
+	s = s[:maxLength]
+	if i := strings.LastIndexByte(s, '-'); i > 0 {
+		s = s[:i]
+	}
+	return strings.Trim(s, "-")
+}
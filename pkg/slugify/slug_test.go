@@ -0,0 +1,39 @@
+package slugify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"Первая новость", "pervaya-novost"},
+		{"Срочные новости!", "srochnye-novosti"},
+		{"Щука и ёж", "shchuka-i-yozh"},
+		{"Обновление технологий: Go 1.23", "obnovlenie-tekhnologiy-go-1-23"},
+		{"  Café -- déjà vu  ", "cafe-deja-vu"},
+		{"Hello, World", "hello-world"},
+		{"!!!", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Make(tt.text); got != tt.expected {
+			t.Errorf("Make(%q) = %q, expected %q", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestMake_TruncatesAtWordBoundary(t *testing.T) {
+	text := strings.Repeat("слово ", 100)
+
+	got := Make(text)
+	if len(got) > MaxLength {
+		t.Fatalf("Expected slug of at most %d bytes, got %d", MaxLength, len(got))
+	}
+	if strings.HasSuffix(got, "-") || !strings.HasSuffix(got, "slovo") {
+		t.Errorf("Expected slug to end with a whole word, got %q", got)
+	}
+}
@@ -9,6 +9,7 @@ package news
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,13 +22,234 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type NewsStatus int32
+
+const (
+	NewsStatus_NEWS_STATUS_UNSPECIFIED NewsStatus = 0
+	NewsStatus_NEWS_STATUS_DRAFT       NewsStatus = 1
+	// Будет опубликована планировщиком в publish_at
+	NewsStatus_NEWS_STATUS_SCHEDULED NewsStatus = 2
+	NewsStatus_NEWS_STATUS_PUBLISHED NewsStatus = 3
+	NewsStatus_NEWS_STATUS_ARCHIVED  NewsStatus = 4
+)
+
+// Enum value maps for NewsStatus.
+var (
+	NewsStatus_name = map[int32]string{
+		0: "NEWS_STATUS_UNSPECIFIED",
+		1: "NEWS_STATUS_DRAFT",
+		2: "NEWS_STATUS_SCHEDULED",
+		3: "NEWS_STATUS_PUBLISHED",
+		4: "NEWS_STATUS_ARCHIVED",
+	}
+	NewsStatus_value = map[string]int32{
+		"NEWS_STATUS_UNSPECIFIED": 0,
+		"NEWS_STATUS_DRAFT":       1,
+		"NEWS_STATUS_SCHEDULED":   2,
+		"NEWS_STATUS_PUBLISHED":   3,
+		"NEWS_STATUS_ARCHIVED":    4,
+	}
+)
+
+func (x NewsStatus) Enum() *NewsStatus {
+	p := new(NewsStatus)
+	*p = x
+	return p
+}
+
+func (x NewsStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NewsStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_news_news_proto_enumTypes[0].Descriptor()
+}
+
+func (NewsStatus) Type() protoreflect.EnumType {
+	return &file_proto_news_news_proto_enumTypes[0]
+}
+
+func (x NewsStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NewsStatus.Descriptor instead.
+func (NewsStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{0}
+}
+
+type SortField int32
+
+const (
+	SortField_SORT_FIELD_UNSPECIFIED SortField = 0
+	SortField_SORT_FIELD_CREATED_AT  SortField = 1
+	SortField_SORT_FIELD_UPDATED_AT  SortField = 2
+	SortField_SORT_FIELD_TITLE       SortField = 3
+)
+
+// Enum value maps for SortField.
+var (
+	SortField_name = map[int32]string{
+		0: "SORT_FIELD_UNSPECIFIED",
+		1: "SORT_FIELD_CREATED_AT",
+		2: "SORT_FIELD_UPDATED_AT",
+		3: "SORT_FIELD_TITLE",
+	}
+	SortField_value = map[string]int32{
+		"SORT_FIELD_UNSPECIFIED": 0,
+		"SORT_FIELD_CREATED_AT":  1,
+		"SORT_FIELD_UPDATED_AT":  2,
+		"SORT_FIELD_TITLE":       3,
+	}
+)
+
+func (x SortField) Enum() *SortField {
+	p := new(SortField)
+	*p = x
+	return p
+}
+
+func (x SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_news_news_proto_enumTypes[1].Descriptor()
+}
+
+func (SortField) Type() protoreflect.EnumType {
+	return &file_proto_news_news_proto_enumTypes[1]
+}
+
+func (x SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortField.Descriptor instead.
+func (SortField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{1}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	SortOrder_SORT_ORDER_ASC         SortOrder = 1
+	SortOrder_SORT_ORDER_DESC        SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_ASC",
+		2: "SORT_ORDER_DESC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_ASC":         1,
+		"SORT_ORDER_DESC":        2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_news_news_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_news_news_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{2}
+}
+
+type DiffOp int32
+
+const (
+	DiffOp_DIFF_OP_UNSPECIFIED DiffOp = 0
+	DiffOp_DIFF_OP_EQUAL       DiffOp = 1
+	DiffOp_DIFF_OP_INSERT      DiffOp = 2
+	DiffOp_DIFF_OP_DELETE      DiffOp = 3
+)
+
+// Enum value maps for DiffOp.
+var (
+	DiffOp_name = map[int32]string{
+		0: "DIFF_OP_UNSPECIFIED",
+		1: "DIFF_OP_EQUAL",
+		2: "DIFF_OP_INSERT",
+		3: "DIFF_OP_DELETE",
+	}
+	DiffOp_value = map[string]int32{
+		"DIFF_OP_UNSPECIFIED": 0,
+		"DIFF_OP_EQUAL":       1,
+		"DIFF_OP_INSERT":      2,
+		"DIFF_OP_DELETE":      3,
+	}
+)
+
+func (x DiffOp) Enum() *DiffOp {
+	p := new(DiffOp)
+	*p = x
+	return p
+}
+
+func (x DiffOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiffOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_news_news_proto_enumTypes[3].Descriptor()
+}
+
+func (DiffOp) Type() protoreflect.EnumType {
+	return &file_proto_news_news_proto_enumTypes[3]
+}
+
+func (x DiffOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiffOp.Descriptor instead.
+func (DiffOp) EnumDescriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{3}
+}
+
 type News struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Slug      string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title     string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content   string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	CreatedAt int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Версия увеличивается при каждом изменении новости
+	Version int64 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// Время удаления (Unix timestamp) для новостей в корзине, иначе 0
+	DeletedAt int64 `protobuf:"varint,7,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Теги в нижнем регистре, по алфавиту
+	Tags   []string   `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Status NewsStatus `protobuf:"varint,9,opt,name=status,proto3,enum=news.NewsStatus" json:"status,omitempty"`
+	// Время публикации (Unix timestamp), 0 если не задано
+	PublishAt int64 `protobuf:"varint,10,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// Пользователи (sub из токена), создавший и последним изменивший новость
+	CreatedBy     string `protobuf:"bytes,11,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string `protobuf:"bytes,12,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,11 +319,66 @@ func (x *News) GetUpdatedAt() int64 {
 	return 0
 }
 
+func (x *News) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *News) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+func (x *News) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *News) GetStatus() NewsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NewsStatus_NEWS_STATUS_UNSPECIFIED
+}
+
+func (x *News) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *News) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *News) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
 type CreateNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Необязательный: если пустой, slug генерируется из заголовка
+	// (с транслитерацией кириллицы) и возвращается в ответе
+	Slug    string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title   string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// По умолчанию новость публикуется сразу. PUBLISHED с publish_at
+	// в будущем равносилен SCHEDULED.
+	Status        NewsStatus `protobuf:"varint,4,opt,name=status,proto3,enum=news.NewsStatus" json:"status,omitempty"`
+	PublishAt     int64      `protobuf:"varint,5,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -157,10 +434,28 @@ func (x *CreateNewsRequest) GetContent() string {
 	return ""
 }
 
+func (x *CreateNewsRequest) GetStatus() NewsStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NewsStatus_NEWS_STATUS_UNSPECIFIED
+}
+
+func (x *CreateNewsRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
 type CreateNewsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	News  *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	// Устаревшее поле: заполняется только в режиме совместимости
+	// (legacy_error_field), иначе ошибка возвращается статусом gRPC.
+	//
+	// Deprecated: Marked as deprecated in proto/news/news.proto.
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -202,6 +497,7 @@ func (x *CreateNewsResponse) GetNews() *News {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/news/news.proto.
 func (x *CreateNewsResponse) GetError() string {
 	if x != nil {
 		return x.Error
@@ -210,10 +506,12 @@ func (x *CreateNewsResponse) GetError() string {
 }
 
 type GetNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Вернуть новость, даже если она не опубликована
+	IncludeUnpublished bool `protobuf:"varint,2,opt,name=include_unpublished,json=includeUnpublished,proto3" json:"include_unpublished,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *GetNewsRequest) Reset() {
@@ -253,10 +551,23 @@ func (x *GetNewsRequest) GetSlug() string {
 	return ""
 }
 
+func (x *GetNewsRequest) GetIncludeUnpublished() bool {
+	if x != nil {
+		return x.IncludeUnpublished
+	}
+	return false
+}
+
 type GetNewsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	News  *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	// Устаревшее поле: см. CreateNewsResponse.error.
+	//
+	// Deprecated: Marked as deprecated in proto/news/news.proto.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// true, если запрошен старый slug переименованной новости;
+	// актуальный slug находится в news.slug
+	Redirected    bool `protobuf:"varint,3,opt,name=redirected,proto3" json:"redirected,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -298,6 +609,7 @@ func (x *GetNewsResponse) GetNews() *News {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/news/news.proto.
 func (x *GetNewsResponse) GetError() string {
 	if x != nil {
 		return x.Error
@@ -305,10 +617,41 @@ func (x *GetNewsResponse) GetError() string {
 	return ""
 }
 
+func (x *GetNewsResponse) GetRedirected() bool {
+	if x != nil {
+		return x.Redirected
+	}
+	return false
+}
+
 type GetNewsListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Page  int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Токен из next_page_token предыдущего ответа (keyset-пагинация).
+	// Если задан, page игнорируется.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Не считать общее количество новостей: total в ответе будет равен -1
+	SkipTotal bool `protobuf:"varint,4,opt,name=skip_total,json=skipTotal,proto3" json:"skip_total,omitempty"`
+	// Фильтры по датам (Unix timestamp), 0 - без ограничения.
+	// Нижняя граница включается, верхняя нет.
+	CreatedAfter  int64 `protobuf:"varint,5,opt,name=created_after,json=createdAfter,proto3" json:"created_after,omitempty"`
+	CreatedBefore int64 `protobuf:"varint,6,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	UpdatedAfter  int64 `protobuf:"varint,7,opt,name=updated_after,json=updatedAfter,proto3" json:"updated_after,omitempty"`
+	UpdatedBefore int64 `protobuf:"varint,8,opt,name=updated_before,json=updatedBefore,proto3" json:"updated_before,omitempty"`
+	// Начало заголовка без учета регистра
+	TitlePrefix string    `protobuf:"bytes,9,opt,name=title_prefix,json=titlePrefix,proto3" json:"title_prefix,omitempty"`
+	SortBy      SortField `protobuf:"varint,10,opt,name=sort_by,json=sortBy,proto3,enum=news.SortField" json:"sort_by,omitempty"`
+	// По умолчанию даты сортируются по убыванию, заголовок - по возрастанию.
+	// page_token действителен только для того же порядка сортировки.
+	SortOrder SortOrder `protobuf:"varint,11,opt,name=sort_order,json=sortOrder,proto3,enum=news.SortOrder" json:"sort_order,omitempty"`
+	// Новости, у которых есть хотя бы один из тегов
+	Tags []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Включить черновики, отложенные и архивные новости
+	IncludeUnpublished bool `protobuf:"varint,13,opt,name=include_unpublished,json=includeUnpublished,proto3" json:"include_unpublished,omitempty"`
+	// Новости, созданные или последними измененные пользователем
+	CreatedBy     string `protobuf:"bytes,14,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedBy     string `protobuf:"bytes,15,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -357,11 +700,107 @@ func (x *GetNewsListRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *GetNewsListRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetNewsListRequest) GetSkipTotal() bool {
+	if x != nil {
+		return x.SkipTotal
+	}
+	return false
+}
+
+func (x *GetNewsListRequest) GetCreatedAfter() int64 {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *GetNewsListRequest) GetCreatedBefore() int64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *GetNewsListRequest) GetUpdatedAfter() int64 {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return 0
+}
+
+func (x *GetNewsListRequest) GetUpdatedBefore() int64 {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return 0
+}
+
+func (x *GetNewsListRequest) GetTitlePrefix() string {
+	if x != nil {
+		return x.TitlePrefix
+	}
+	return ""
+}
+
+func (x *GetNewsListRequest) GetSortBy() SortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortField_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *GetNewsListRequest) GetSortOrder() SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *GetNewsListRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *GetNewsListRequest) GetIncludeUnpublished() bool {
+	if x != nil {
+		return x.IncludeUnpublished
+	}
+	return false
+}
+
+func (x *GetNewsListRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *GetNewsListRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
 type GetNewsListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	News  []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	Total int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Устаревшее поле: см. CreateNewsResponse.error.
+	//
+	// Deprecated: Marked as deprecated in proto/news/news.proto.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Токен следующей страницы; пустой, если это последняя страница
+	NextPageToken string `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -410,6 +849,7 @@ func (x *GetNewsListResponse) GetTotal() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in proto/news/news.proto.
 func (x *GetNewsListResponse) GetError() string {
 	if x != nil {
 		return x.Error
@@ -417,13 +857,26 @@ func (x *GetNewsListResponse) GetError() string {
 	return ""
 }
 
+func (x *GetNewsListResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type UpdateNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Slug    string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	// Поля, которые нужно изменить: "title", "content".
+	// Пустая маска означает полное обновление (title и content обязательны).
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Если больше 0, обновление выполняется только при совпадении с текущей
+	// версией новости, иначе возвращается ABORTED
+	ExpectedVersion int64 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateNewsRequest) Reset() {
@@ -477,10 +930,27 @@ func (x *UpdateNewsRequest) GetContent() string {
 	return ""
 }
 
+func (x *UpdateNewsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateNewsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateNewsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	News  *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	// Устаревшее поле: см. CreateNewsResponse.error.
+	//
+	// Deprecated: Marked as deprecated in proto/news/news.proto.
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -522,6 +992,7 @@ func (x *UpdateNewsResponse) GetNews() *News {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in proto/news/news.proto.
 func (x *UpdateNewsResponse) GetError() string {
 	if x != nil {
 		return x.Error
@@ -530,10 +1001,12 @@ func (x *UpdateNewsResponse) GetError() string {
 }
 
 type DeleteNewsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// См. UpdateNewsRequest.expected_version
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *DeleteNewsRequest) Reset() {
@@ -573,10 +1046,20 @@ func (x *DeleteNewsRequest) GetSlug() string {
 	return ""
 }
 
+func (x *DeleteNewsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type DeleteNewsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// Устаревшее поле: см. CreateNewsResponse.error.
+	//
+	// Deprecated: Marked as deprecated in proto/news/news.proto.
+	Error         string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -618,6 +1101,7 @@ func (x *DeleteNewsResponse) GetSuccess() bool {
 	return false
 }
 
+// Deprecated: Marked as deprecated in proto/news/news.proto.
 func (x *DeleteNewsResponse) GetError() string {
 	if x != nil {
 		return x.Error
@@ -625,11 +1109,1519 @@ func (x *DeleteNewsResponse) GetError() string {
 	return ""
 }
 
-var File_proto_news_news_proto protoreflect.FileDescriptor
+type RenameNewsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Slug    string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	NewSlug string                 `protobuf:"bytes,2,opt,name=new_slug,json=newSlug,proto3" json:"new_slug,omitempty"`
+	// См. UpdateNewsRequest.expected_version
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-const file_proto_news_news_proto_rawDesc = "" +
+func (x *RenameNewsRequest) Reset() {
+	*x = RenameNewsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameNewsRequest) ProtoMessage() {}
+
+func (x *RenameNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameNewsRequest.ProtoReflect.Descriptor instead.
+func (*RenameNewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RenameNewsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RenameNewsRequest) GetNewSlug() string {
+	if x != nil {
+		return x.NewSlug
+	}
+	return ""
+}
+
+func (x *RenameNewsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RenameNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameNewsResponse) Reset() {
+	*x = RenameNewsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameNewsResponse) ProtoMessage() {}
+
+func (x *RenameNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameNewsResponse.ProtoReflect.Descriptor instead.
+func (*RenameNewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RenameNewsResponse) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type RestoreNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNewsRequest) Reset() {
+	*x = RestoreNewsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNewsRequest) ProtoMessage() {}
+
+func (x *RestoreNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNewsRequest.ProtoReflect.Descriptor instead.
+func (*RestoreNewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RestoreNewsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type RestoreNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreNewsResponse) Reset() {
+	*x = RestoreNewsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreNewsResponse) ProtoMessage() {}
+
+func (x *RestoreNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreNewsResponse.ProtoReflect.Descriptor instead.
+func (*RestoreNewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RestoreNewsResponse) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type ListDeletedNewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedNewsRequest) Reset() {
+	*x = ListDeletedNewsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedNewsRequest) ProtoMessage() {}
+
+func (x *ListDeletedNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedNewsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedNewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListDeletedNewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedNewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDeletedNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          []*News                `protobuf:"bytes,1,rep,name=news,proto3" json:"news,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedNewsResponse) Reset() {
+	*x = ListDeletedNewsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedNewsResponse) ProtoMessage() {}
+
+func (x *ListDeletedNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedNewsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedNewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListDeletedNewsResponse) GetNews() []*News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *ListDeletedNewsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SearchNewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Поисковый запрос в синтаксисе websearch_to_tsquery:
+	// слова, "точные фразы", or, -исключения
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNewsRequest) Reset() {
+	*x = SearchNewsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNewsRequest) ProtoMessage() {}
+
+func (x *SearchNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNewsRequest.ProtoReflect.Descriptor instead.
+func (*SearchNewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchNewsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchNewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchNewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchNewsResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	News  *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	// Релевантность по ts_rank, результаты отсортированы по убыванию
+	Rank float32 `protobuf:"fixed32,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Заголовок и фрагменты содержимого с найденными словами в <b>...</b>
+	TitleHighlight string `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	Snippet        string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchNewsResult) Reset() {
+	*x = SearchNewsResult{}
+	mi := &file_proto_news_news_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNewsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNewsResult) ProtoMessage() {}
+
+func (x *SearchNewsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNewsResult.ProtoReflect.Descriptor instead.
+func (*SearchNewsResult) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SearchNewsResult) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+func (x *SearchNewsResult) GetRank() float32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchNewsResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *SearchNewsResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchNewsResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchNewsResponse) Reset() {
+	*x = SearchNewsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchNewsResponse) ProtoMessage() {}
+
+func (x *SearchNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchNewsResponse.ProtoReflect.Descriptor instead.
+func (*SearchNewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SearchNewsResponse) GetResults() []*SearchNewsResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchNewsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SetNewsTagsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Полный набор тегов новости: прежние теги заменяются, пустой список снимает все
+	Tags          []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNewsTagsRequest) Reset() {
+	*x = SetNewsTagsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNewsTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNewsTagsRequest) ProtoMessage() {}
+
+func (x *SetNewsTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNewsTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetNewsTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetNewsTagsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SetNewsTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type SetNewsTagsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNewsTagsResponse) Reset() {
+	*x = SetNewsTagsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNewsTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNewsTagsResponse) ProtoMessage() {}
+
+func (x *SetNewsTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNewsTagsResponse.ProtoReflect.Descriptor instead.
+func (*SetNewsTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetNewsTagsResponse) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type ListTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{22}
+}
+
+type TagCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Количество опубликованных и уже видимых читателям новостей с тегом
+	Count         int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	mi := &file_proto_news_news_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TagCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TagCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListTagsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Используемые теги, самые популярные первыми
+	Tags          []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type PublishNewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Unix timestamp; время в будущем откладывает публикацию, 0 - опубликовать сразу
+	PublishAt int64 `protobuf:"varint,2,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// См. UpdateNewsRequest.expected_version
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PublishNewsRequest) Reset() {
+	*x = PublishNewsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishNewsRequest) ProtoMessage() {}
+
+func (x *PublishNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishNewsRequest.ProtoReflect.Descriptor instead.
+func (*PublishNewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PublishNewsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *PublishNewsRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *PublishNewsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type PublishNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishNewsResponse) Reset() {
+	*x = PublishNewsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishNewsResponse) ProtoMessage() {}
+
+func (x *PublishNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishNewsResponse.ProtoReflect.Descriptor instead.
+func (*PublishNewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PublishNewsResponse) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+type UnpublishNewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// true переносит новость в архив, false возвращает в черновики
+	Archive bool `protobuf:"varint,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	// См. UpdateNewsRequest.expected_version
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnpublishNewsRequest) Reset() {
+	*x = UnpublishNewsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishNewsRequest) ProtoMessage() {}
+
+func (x *UnpublishNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishNewsRequest.ProtoReflect.Descriptor instead.
+func (*UnpublishNewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UnpublishNewsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *UnpublishNewsRequest) GetArchive() bool {
+	if x != nil {
+		return x.Archive
+	}
+	return false
+}
+
+func (x *UnpublishNewsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UnpublishNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnpublishNewsResponse) Reset() {
+	*x = UnpublishNewsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnpublishNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpublishNewsResponse) ProtoMessage() {}
+
+func (x *UnpublishNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpublishNewsResponse.ProtoReflect.Descriptor instead.
+func (*UnpublishNewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnpublishNewsResponse) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+// Снимок новости после каждого изменения, увеличившего версию: создания,
+// обновления, переименования, смены статуса (в том числе отложенной
+// публикации), удаления в корзину и восстановления
+type NewsRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Версия, которую новость получила этим изменением
+	Version       int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Author        string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt     int64  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewsRevision) Reset() {
+	*x = NewsRevision{}
+	mi := &file_proto_news_news_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewsRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewsRevision) ProtoMessage() {}
+
+func (x *NewsRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewsRevision.ProtoReflect.Descriptor instead.
+func (*NewsRevision) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *NewsRevision) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *NewsRevision) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NewsRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NewsRevision) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NewsRevision) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *NewsRevision) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListNewsRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNewsRevisionsRequest) Reset() {
+	*x = ListNewsRevisionsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNewsRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNewsRevisionsRequest) ProtoMessage() {}
+
+func (x *ListNewsRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNewsRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListNewsRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListNewsRevisionsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ListNewsRevisionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNewsRevisionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListNewsRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Последние ревизии первыми
+	Revisions     []*NewsRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	Total         int64           `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNewsRevisionsResponse) Reset() {
+	*x = ListNewsRevisionsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNewsRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNewsRevisionsResponse) ProtoMessage() {}
+
+func (x *ListNewsRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNewsRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListNewsRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListNewsRevisionsResponse) GetRevisions() []*NewsRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListNewsRevisionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetNewsRevisionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsRevisionRequest) Reset() {
+	*x = GetNewsRevisionRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsRevisionRequest) ProtoMessage() {}
+
+func (x *GetNewsRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsRevisionRequest.ProtoReflect.Descriptor instead.
+func (*GetNewsRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetNewsRevisionRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *GetNewsRevisionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type GetNewsRevisionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      *NewsRevision          `protobuf:"bytes,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNewsRevisionResponse) Reset() {
+	*x = GetNewsRevisionResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNewsRevisionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewsRevisionResponse) ProtoMessage() {}
+
+func (x *GetNewsRevisionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewsRevisionResponse.ProtoReflect.Descriptor instead.
+func (*GetNewsRevisionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetNewsRevisionResponse) GetRevision() *NewsRevision {
+	if x != nil {
+		return x.Revision
+	}
+	return nil
+}
+
+type DiffNewsRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slug          string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	FromVersion   int64                  `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion     int64                  `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNewsRevisionsRequest) Reset() {
+	*x = DiffNewsRevisionsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNewsRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNewsRevisionsRequest) ProtoMessage() {}
+
+func (x *DiffNewsRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNewsRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*DiffNewsRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DiffNewsRevisionsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *DiffNewsRevisionsRequest) GetFromVersion() int64 {
+	if x != nil {
+		return x.FromVersion
+	}
+	return 0
+}
+
+func (x *DiffNewsRevisionsRequest) GetToVersion() int64 {
+	if x != nil {
+		return x.ToVersion
+	}
+	return 0
+}
+
+type DiffLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Op            DiffOp                 `protobuf:"varint,1,opt,name=op,proto3,enum=news.DiffOp" json:"op,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffLine) Reset() {
+	*x = DiffLine{}
+	mi := &file_proto_news_news_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffLine) ProtoMessage() {}
+
+func (x *DiffLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffLine.ProtoReflect.Descriptor instead.
+func (*DiffLine) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DiffLine) GetOp() DiffOp {
+	if x != nil {
+		return x.Op
+	}
+	return DiffOp_DIFF_OP_UNSPECIFIED
+}
+
+func (x *DiffLine) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type DiffNewsRevisionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *NewsRevision          `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *NewsRevision          `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Построчные изменения заголовка и содержимого от from к to
+	Title         []*DiffLine `protobuf:"bytes,3,rep,name=title,proto3" json:"title,omitempty"`
+	Content       []*DiffLine `protobuf:"bytes,4,rep,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffNewsRevisionsResponse) Reset() {
+	*x = DiffNewsRevisionsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffNewsRevisionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffNewsRevisionsResponse) ProtoMessage() {}
+
+func (x *DiffNewsRevisionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffNewsRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*DiffNewsRevisionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DiffNewsRevisionsResponse) GetFrom() *NewsRevision {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DiffNewsRevisionsResponse) GetTo() *NewsRevision {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DiffNewsRevisionsResponse) GetTitle() []*DiffLine {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *DiffNewsRevisionsResponse) GetContent() []*DiffLine {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type RollbackNewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Slug  string                 `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	// Ревизия, заголовок и содержимое которой нужно вернуть
+	Version int64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// См. UpdateNewsRequest.expected_version
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RollbackNewsRequest) Reset() {
+	*x = RollbackNewsRequest{}
+	mi := &file_proto_news_news_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackNewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackNewsRequest) ProtoMessage() {}
+
+func (x *RollbackNewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackNewsRequest.ProtoReflect.Descriptor instead.
+func (*RollbackNewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RollbackNewsRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RollbackNewsRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *RollbackNewsRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type RollbackNewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	News          *News                  `protobuf:"bytes,1,opt,name=news,proto3" json:"news,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollbackNewsResponse) Reset() {
+	*x = RollbackNewsResponse{}
+	mi := &file_proto_news_news_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollbackNewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollbackNewsResponse) ProtoMessage() {}
+
+func (x *RollbackNewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_news_news_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollbackNewsResponse.ProtoReflect.Descriptor instead.
+func (*RollbackNewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_news_news_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RollbackNewsResponse) GetNews() *News {
+	if x != nil {
+		return x.News
+	}
+	return nil
+}
+
+var File_proto_news_news_proto protoreflect.FileDescriptor
+
+const file_proto_news_news_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/news/news.proto\x12\x04news\"\x88\x01\n" +
+	"\x15proto/news/news.proto\x12\x04news\x1a google/protobuf/field_mask.proto\"\xdc\x02\n" +
 	"\x04News\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
@@ -637,42 +2629,205 @@ const file_proto_news_news_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\"W\n" +
+	"updated_at\x18\x05 \x01(\x03R\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\a \x01(\x03R\tdeletedAt\x12\x12\n" +
+	"\x04tags\x18\b \x03(\tR\x04tags\x12(\n" +
+	"\x06status\x18\t \x01(\x0e2\x10.news.NewsStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\n" +
+	" \x01(\x03R\tpublishAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\v \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\f \x01(\tR\tupdatedBy\"\xa0\x01\n" +
 	"\x11CreateNewsRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"J\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12(\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x10.news.NewsStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x05 \x01(\x03R\tpublishAt\"N\n" +
 	"\x12CreateNewsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x01(\v2\n" +
-	".news.NewsR\x04news\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"$\n" +
+	".news.NewsR\x04news\x12\x18\n" +
+	"\x05error\x18\x02 \x01(\tB\x02\x18\x01R\x05error\"U\n" +
 	"\x0eGetNewsRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"G\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12/\n" +
+	"\x13include_unpublished\x18\x02 \x01(\bR\x12includeUnpublished\"k\n" +
 	"\x0fGetNewsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x01(\v2\n" +
-	".news.NewsR\x04news\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\">\n" +
+	".news.NewsR\x04news\x12\x18\n" +
+	"\x05error\x18\x02 \x01(\tB\x02\x18\x01R\x05error\x12\x1e\n" +
+	"\n" +
+	"redirected\x18\x03 \x01(\bR\n" +
+	"redirected\"\x94\x04\n" +
 	"\x12GetNewsListRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"a\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12\x1d\n" +
+	"\n" +
+	"skip_total\x18\x04 \x01(\bR\tskipTotal\x12#\n" +
+	"\rcreated_after\x18\x05 \x01(\x03R\fcreatedAfter\x12%\n" +
+	"\x0ecreated_before\x18\x06 \x01(\x03R\rcreatedBefore\x12#\n" +
+	"\rupdated_after\x18\a \x01(\x03R\fupdatedAfter\x12%\n" +
+	"\x0eupdated_before\x18\b \x01(\x03R\rupdatedBefore\x12!\n" +
+	"\ftitle_prefix\x18\t \x01(\tR\vtitlePrefix\x12(\n" +
+	"\asort_by\x18\n" +
+	" \x01(\x0e2\x0f.news.SortFieldR\x06sortBy\x12.\n" +
+	"\n" +
+	"sort_order\x18\v \x01(\x0e2\x0f.news.SortOrderR\tsortOrder\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12/\n" +
+	"\x13include_unpublished\x18\r \x01(\bR\x12includeUnpublished\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\x0e \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x0f \x01(\tR\tupdatedBy\"\x8d\x01\n" +
 	"\x13GetNewsListResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x03(\v2\n" +
 	".news.NewsR\x04news\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x14\n" +
-	"\x05error\x18\x03 \x01(\tR\x05error\"W\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x18\n" +
+	"\x05error\x18\x03 \x01(\tB\x02\x18\x01R\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xbf\x01\n" +
 	"\x11UpdateNewsRequest\x12\x12\n" +
 	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"J\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12;\n" +
+	"\vupdate_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10expected_version\x18\x05 \x01(\x03R\x0fexpectedVersion\"N\n" +
 	"\x12UpdateNewsResponse\x12\x1e\n" +
 	"\x04news\x18\x01 \x01(\v2\n" +
-	".news.NewsR\x04news\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"'\n" +
+	".news.NewsR\x04news\x12\x18\n" +
+	"\x05error\x18\x02 \x01(\tB\x02\x18\x01R\x05error\"R\n" +
 	"\x11DeleteNewsRequest\x12\x12\n" +
-	"\x04slug\x18\x01 \x01(\tR\x04slug\"D\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"H\n" +
 	"\x12DeleteNewsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error2\xcc\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\x05error\x18\x02 \x01(\tB\x02\x18\x01R\x05error\"m\n" +
+	"\x11RenameNewsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x19\n" +
+	"\bnew_slug\x18\x02 \x01(\tR\anewSlug\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"4\n" +
+	"\x12RenameNewsResponse\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news\"(\n" +
+	"\x12RestoreNewsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\"5\n" +
+	"\x13RestoreNewsResponse\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news\"B\n" +
+	"\x16ListDeletedNewsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"O\n" +
+	"\x17ListDeletedNewsResponse\x12\x1e\n" +
+	"\x04news\x18\x01 \x03(\v2\n" +
+	".news.NewsR\x04news\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"S\n" +
+	"\x11SearchNewsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x89\x01\n" +
+	"\x10SearchNewsResult\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x02R\x04rank\x12'\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0etitleHighlight\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\"\\\n" +
+	"\x12SearchNewsResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.news.SearchNewsResultR\aresults\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"<\n" +
+	"\x12SetNewsTagsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"5\n" +
+	"\x13SetNewsTagsResponse\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news\"\x11\n" +
+	"\x0fListTagsRequest\"4\n" +
+	"\bTagCount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\"6\n" +
+	"\x10ListTagsResponse\x12\"\n" +
+	"\x04tags\x18\x01 \x03(\v2\x0e.news.TagCountR\x04tags\"r\n" +
+	"\x12PublishNewsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x02 \x01(\x03R\tpublishAt\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"5\n" +
+	"\x13PublishNewsResponse\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news\"o\n" +
+	"\x14UnpublishNewsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x18\n" +
+	"\aarchive\x18\x02 \x01(\bR\aarchive\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"7\n" +
+	"\x15UnpublishNewsResponse\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news\"\xa3\x01\n" +
+	"\fNewsRevision\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x16\n" +
+	"\x06author\x18\x05 \x01(\tR\x06author\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\"X\n" +
+	"\x18ListNewsRevisionsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"c\n" +
+	"\x19ListNewsRevisionsResponse\x120\n" +
+	"\trevisions\x18\x01 \x03(\v2\x12.news.NewsRevisionR\trevisions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"F\n" +
+	"\x16GetNewsRevisionRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"I\n" +
+	"\x17GetNewsRevisionResponse\x12.\n" +
+	"\brevision\x18\x01 \x01(\v2\x12.news.NewsRevisionR\brevision\"p\n" +
+	"\x18DiffNewsRevisionsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12!\n" +
+	"\ffrom_version\x18\x02 \x01(\x03R\vfromVersion\x12\x1d\n" +
+	"\n" +
+	"to_version\x18\x03 \x01(\x03R\ttoVersion\"<\n" +
+	"\bDiffLine\x12\x1c\n" +
+	"\x02op\x18\x01 \x01(\x0e2\f.news.DiffOpR\x02op\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\xb7\x01\n" +
+	"\x19DiffNewsRevisionsResponse\x12&\n" +
+	"\x04from\x18\x01 \x01(\v2\x12.news.NewsRevisionR\x04from\x12\"\n" +
+	"\x02to\x18\x02 \x01(\v2\x12.news.NewsRevisionR\x02to\x12$\n" +
+	"\x05title\x18\x03 \x03(\v2\x0e.news.DiffLineR\x05title\x12(\n" +
+	"\acontent\x18\x04 \x03(\v2\x0e.news.DiffLineR\acontent\"n\n" +
+	"\x13RollbackNewsRequest\x12\x12\n" +
+	"\x04slug\x18\x01 \x01(\tR\x04slug\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"6\n" +
+	"\x14RollbackNewsResponse\x12\x1e\n" +
+	"\x04news\x18\x01 \x01(\v2\n" +
+	".news.NewsR\x04news*\x90\x01\n" +
+	"\n" +
+	"NewsStatus\x12\x1b\n" +
+	"\x17NEWS_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11NEWS_STATUS_DRAFT\x10\x01\x12\x19\n" +
+	"\x15NEWS_STATUS_SCHEDULED\x10\x02\x12\x19\n" +
+	"\x15NEWS_STATUS_PUBLISHED\x10\x03\x12\x18\n" +
+	"\x14NEWS_STATUS_ARCHIVED\x10\x04*s\n" +
+	"\tSortField\x12\x1a\n" +
+	"\x16SORT_FIELD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SORT_FIELD_CREATED_AT\x10\x01\x12\x19\n" +
+	"\x15SORT_FIELD_UPDATED_AT\x10\x02\x12\x14\n" +
+	"\x10SORT_FIELD_TITLE\x10\x03*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x02*\\\n" +
+	"\x06DiffOp\x12\x17\n" +
+	"\x13DIFF_OP_UNSPECIFIED\x10\x00\x12\x11\n" +
+	"\rDIFF_OP_EQUAL\x10\x01\x12\x12\n" +
+	"\x0eDIFF_OP_INSERT\x10\x02\x12\x12\n" +
+	"\x0eDIFF_OP_DELETE\x10\x032\xb2\t\n" +
 	"\vNewsService\x12?\n" +
 	"\n" +
 	"CreateNews\x12\x17.news.CreateNewsRequest\x1a\x18.news.CreateNewsResponse\x126\n" +
@@ -681,7 +2836,21 @@ const file_proto_news_news_proto_rawDesc = "" +
 	"\n" +
 	"UpdateNews\x12\x17.news.UpdateNewsRequest\x1a\x18.news.UpdateNewsResponse\x12?\n" +
 	"\n" +
-	"DeleteNews\x12\x17.news.DeleteNewsRequest\x1a\x18.news.DeleteNewsResponseB\x19Z\x17news-service/proto/newsb\x06proto3"
+	"DeleteNews\x12\x17.news.DeleteNewsRequest\x1a\x18.news.DeleteNewsResponse\x12?\n" +
+	"\n" +
+	"RenameNews\x12\x17.news.RenameNewsRequest\x1a\x18.news.RenameNewsResponse\x12B\n" +
+	"\vRestoreNews\x12\x18.news.RestoreNewsRequest\x1a\x19.news.RestoreNewsResponse\x12N\n" +
+	"\x0fListDeletedNews\x12\x1c.news.ListDeletedNewsRequest\x1a\x1d.news.ListDeletedNewsResponse\x12?\n" +
+	"\n" +
+	"SearchNews\x12\x17.news.SearchNewsRequest\x1a\x18.news.SearchNewsResponse\x12B\n" +
+	"\vSetNewsTags\x12\x18.news.SetNewsTagsRequest\x1a\x19.news.SetNewsTagsResponse\x129\n" +
+	"\bListTags\x12\x15.news.ListTagsRequest\x1a\x16.news.ListTagsResponse\x12B\n" +
+	"\vPublishNews\x12\x18.news.PublishNewsRequest\x1a\x19.news.PublishNewsResponse\x12H\n" +
+	"\rUnpublishNews\x12\x1a.news.UnpublishNewsRequest\x1a\x1b.news.UnpublishNewsResponse\x12T\n" +
+	"\x11ListNewsRevisions\x12\x1e.news.ListNewsRevisionsRequest\x1a\x1f.news.ListNewsRevisionsResponse\x12N\n" +
+	"\x0fGetNewsRevision\x12\x1c.news.GetNewsRevisionRequest\x1a\x1d.news.GetNewsRevisionResponse\x12T\n" +
+	"\x11DiffNewsRevisions\x12\x1e.news.DiffNewsRevisionsRequest\x1a\x1f.news.DiffNewsRevisionsResponse\x12E\n" +
+	"\fRollbackNews\x12\x19.news.RollbackNewsRequest\x1a\x1a.news.RollbackNewsResponseB\x19Z\x17news-service/proto/newsb\x06proto3"
 
 var (
 	file_proto_news_news_proto_rawDescOnce sync.Once
@@ -695,40 +2864,120 @@ func file_proto_news_news_proto_rawDescGZIP() []byte {
 	return file_proto_news_news_proto_rawDescData
 }
 
-var file_proto_news_news_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_news_news_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_news_news_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_proto_news_news_proto_goTypes = []any{
-	(*News)(nil),                // 0: news.News
-	(*CreateNewsRequest)(nil),   // 1: news.CreateNewsRequest
-	(*CreateNewsResponse)(nil),  // 2: news.CreateNewsResponse
-	(*GetNewsRequest)(nil),      // 3: news.GetNewsRequest
-	(*GetNewsResponse)(nil),     // 4: news.GetNewsResponse
-	(*GetNewsListRequest)(nil),  // 5: news.GetNewsListRequest
-	(*GetNewsListResponse)(nil), // 6: news.GetNewsListResponse
-	(*UpdateNewsRequest)(nil),   // 7: news.UpdateNewsRequest
-	(*UpdateNewsResponse)(nil),  // 8: news.UpdateNewsResponse
-	(*DeleteNewsRequest)(nil),   // 9: news.DeleteNewsRequest
-	(*DeleteNewsResponse)(nil),  // 10: news.DeleteNewsResponse
+	(NewsStatus)(0),                   // 0: news.NewsStatus
+	(SortField)(0),                    // 1: news.SortField
+	(SortOrder)(0),                    // 2: news.SortOrder
+	(DiffOp)(0),                       // 3: news.DiffOp
+	(*News)(nil),                      // 4: news.News
+	(*CreateNewsRequest)(nil),         // 5: news.CreateNewsRequest
+	(*CreateNewsResponse)(nil),        // 6: news.CreateNewsResponse
+	(*GetNewsRequest)(nil),            // 7: news.GetNewsRequest
+	(*GetNewsResponse)(nil),           // 8: news.GetNewsResponse
+	(*GetNewsListRequest)(nil),        // 9: news.GetNewsListRequest
+	(*GetNewsListResponse)(nil),       // 10: news.GetNewsListResponse
+	(*UpdateNewsRequest)(nil),         // 11: news.UpdateNewsRequest
+	(*UpdateNewsResponse)(nil),        // 12: news.UpdateNewsResponse
+	(*DeleteNewsRequest)(nil),         // 13: news.DeleteNewsRequest
+	(*DeleteNewsResponse)(nil),        // 14: news.DeleteNewsResponse
+	(*RenameNewsRequest)(nil),         // 15: news.RenameNewsRequest
+	(*RenameNewsResponse)(nil),        // 16: news.RenameNewsResponse
+	(*RestoreNewsRequest)(nil),        // 17: news.RestoreNewsRequest
+	(*RestoreNewsResponse)(nil),       // 18: news.RestoreNewsResponse
+	(*ListDeletedNewsRequest)(nil),    // 19: news.ListDeletedNewsRequest
+	(*ListDeletedNewsResponse)(nil),   // 20: news.ListDeletedNewsResponse
+	(*SearchNewsRequest)(nil),         // 21: news.SearchNewsRequest
+	(*SearchNewsResult)(nil),          // 22: news.SearchNewsResult
+	(*SearchNewsResponse)(nil),        // 23: news.SearchNewsResponse
+	(*SetNewsTagsRequest)(nil),        // 24: news.SetNewsTagsRequest
+	(*SetNewsTagsResponse)(nil),       // 25: news.SetNewsTagsResponse
+	(*ListTagsRequest)(nil),           // 26: news.ListTagsRequest
+	(*TagCount)(nil),                  // 27: news.TagCount
+	(*ListTagsResponse)(nil),          // 28: news.ListTagsResponse
+	(*PublishNewsRequest)(nil),        // 29: news.PublishNewsRequest
+	(*PublishNewsResponse)(nil),       // 30: news.PublishNewsResponse
+	(*UnpublishNewsRequest)(nil),      // 31: news.UnpublishNewsRequest
+	(*UnpublishNewsResponse)(nil),     // 32: news.UnpublishNewsResponse
+	(*NewsRevision)(nil),              // 33: news.NewsRevision
+	(*ListNewsRevisionsRequest)(nil),  // 34: news.ListNewsRevisionsRequest
+	(*ListNewsRevisionsResponse)(nil), // 35: news.ListNewsRevisionsResponse
+	(*GetNewsRevisionRequest)(nil),    // 36: news.GetNewsRevisionRequest
+	(*GetNewsRevisionResponse)(nil),   // 37: news.GetNewsRevisionResponse
+	(*DiffNewsRevisionsRequest)(nil),  // 38: news.DiffNewsRevisionsRequest
+	(*DiffLine)(nil),                  // 39: news.DiffLine
+	(*DiffNewsRevisionsResponse)(nil), // 40: news.DiffNewsRevisionsResponse
+	(*RollbackNewsRequest)(nil),       // 41: news.RollbackNewsRequest
+	(*RollbackNewsResponse)(nil),      // 42: news.RollbackNewsResponse
+	(*fieldmaskpb.FieldMask)(nil),     // 43: google.protobuf.FieldMask
 }
 var file_proto_news_news_proto_depIdxs = []int32{
-	0,  // 0: news.CreateNewsResponse.news:type_name -> news.News
-	0,  // 1: news.GetNewsResponse.news:type_name -> news.News
-	0,  // 2: news.GetNewsListResponse.news:type_name -> news.News
-	0,  // 3: news.UpdateNewsResponse.news:type_name -> news.News
-	1,  // 4: news.NewsService.CreateNews:input_type -> news.CreateNewsRequest
-	3,  // 5: news.NewsService.GetNews:input_type -> news.GetNewsRequest
-	5,  // 6: news.NewsService.GetNewsList:input_type -> news.GetNewsListRequest
-	7,  // 7: news.NewsService.UpdateNews:input_type -> news.UpdateNewsRequest
-	9,  // 8: news.NewsService.DeleteNews:input_type -> news.DeleteNewsRequest
-	2,  // 9: news.NewsService.CreateNews:output_type -> news.CreateNewsResponse
-	4,  // 10: news.NewsService.GetNews:output_type -> news.GetNewsResponse
-	6,  // 11: news.NewsService.GetNewsList:output_type -> news.GetNewsListResponse
-	8,  // 12: news.NewsService.UpdateNews:output_type -> news.UpdateNewsResponse
-	10, // 13: news.NewsService.DeleteNews:output_type -> news.DeleteNewsResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 0: news.News.status:type_name -> news.NewsStatus
+	0,  // 1: news.CreateNewsRequest.status:type_name -> news.NewsStatus
+	4,  // 2: news.CreateNewsResponse.news:type_name -> news.News
+	4,  // 3: news.GetNewsResponse.news:type_name -> news.News
+	1,  // 4: news.GetNewsListRequest.sort_by:type_name -> news.SortField
+	2,  // 5: news.GetNewsListRequest.sort_order:type_name -> news.SortOrder
+	4,  // 6: news.GetNewsListResponse.news:type_name -> news.News
+	43, // 7: news.UpdateNewsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	4,  // 8: news.UpdateNewsResponse.news:type_name -> news.News
+	4,  // 9: news.RenameNewsResponse.news:type_name -> news.News
+	4,  // 10: news.RestoreNewsResponse.news:type_name -> news.News
+	4,  // 11: news.ListDeletedNewsResponse.news:type_name -> news.News
+	4,  // 12: news.SearchNewsResult.news:type_name -> news.News
+	22, // 13: news.SearchNewsResponse.results:type_name -> news.SearchNewsResult
+	4,  // 14: news.SetNewsTagsResponse.news:type_name -> news.News
+	27, // 15: news.ListTagsResponse.tags:type_name -> news.TagCount
+	4,  // 16: news.PublishNewsResponse.news:type_name -> news.News
+	4,  // 17: news.UnpublishNewsResponse.news:type_name -> news.News
+	33, // 18: news.ListNewsRevisionsResponse.revisions:type_name -> news.NewsRevision
+	33, // 19: news.GetNewsRevisionResponse.revision:type_name -> news.NewsRevision
+	3,  // 20: news.DiffLine.op:type_name -> news.DiffOp
+	33, // 21: news.DiffNewsRevisionsResponse.from:type_name -> news.NewsRevision
+	33, // 22: news.DiffNewsRevisionsResponse.to:type_name -> news.NewsRevision
+	39, // 23: news.DiffNewsRevisionsResponse.title:type_name -> news.DiffLine
+	39, // 24: news.DiffNewsRevisionsResponse.content:type_name -> news.DiffLine
+	4,  // 25: news.RollbackNewsResponse.news:type_name -> news.News
+	5,  // 26: news.NewsService.CreateNews:input_type -> news.CreateNewsRequest
+	7,  // 27: news.NewsService.GetNews:input_type -> news.GetNewsRequest
+	9,  // 28: news.NewsService.GetNewsList:input_type -> news.GetNewsListRequest
+	11, // 29: news.NewsService.UpdateNews:input_type -> news.UpdateNewsRequest
+	13, // 30: news.NewsService.DeleteNews:input_type -> news.DeleteNewsRequest
+	15, // 31: news.NewsService.RenameNews:input_type -> news.RenameNewsRequest
+	17, // 32: news.NewsService.RestoreNews:input_type -> news.RestoreNewsRequest
+	19, // 33: news.NewsService.ListDeletedNews:input_type -> news.ListDeletedNewsRequest
+	21, // 34: news.NewsService.SearchNews:input_type -> news.SearchNewsRequest
+	24, // 35: news.NewsService.SetNewsTags:input_type -> news.SetNewsTagsRequest
+	26, // 36: news.NewsService.ListTags:input_type -> news.ListTagsRequest
+	29, // 37: news.NewsService.PublishNews:input_type -> news.PublishNewsRequest
+	31, // 38: news.NewsService.UnpublishNews:input_type -> news.UnpublishNewsRequest
+	34, // 39: news.NewsService.ListNewsRevisions:input_type -> news.ListNewsRevisionsRequest
+	36, // 40: news.NewsService.GetNewsRevision:input_type -> news.GetNewsRevisionRequest
+	38, // 41: news.NewsService.DiffNewsRevisions:input_type -> news.DiffNewsRevisionsRequest
+	41, // 42: news.NewsService.RollbackNews:input_type -> news.RollbackNewsRequest
+	6,  // 43: news.NewsService.CreateNews:output_type -> news.CreateNewsResponse
+	8,  // 44: news.NewsService.GetNews:output_type -> news.GetNewsResponse
+	10, // 45: news.NewsService.GetNewsList:output_type -> news.GetNewsListResponse
+	12, // 46: news.NewsService.UpdateNews:output_type -> news.UpdateNewsResponse
+	14, // 47: news.NewsService.DeleteNews:output_type -> news.DeleteNewsResponse
+	16, // 48: news.NewsService.RenameNews:output_type -> news.RenameNewsResponse
+	18, // 49: news.NewsService.RestoreNews:output_type -> news.RestoreNewsResponse
+	20, // 50: news.NewsService.ListDeletedNews:output_type -> news.ListDeletedNewsResponse
+	23, // 51: news.NewsService.SearchNews:output_type -> news.SearchNewsResponse
+	25, // 52: news.NewsService.SetNewsTags:output_type -> news.SetNewsTagsResponse
+	28, // 53: news.NewsService.ListTags:output_type -> news.ListTagsResponse
+	30, // 54: news.NewsService.PublishNews:output_type -> news.PublishNewsResponse
+	32, // 55: news.NewsService.UnpublishNews:output_type -> news.UnpublishNewsResponse
+	35, // 56: news.NewsService.ListNewsRevisions:output_type -> news.ListNewsRevisionsResponse
+	37, // 57: news.NewsService.GetNewsRevision:output_type -> news.GetNewsRevisionResponse
+	40, // 58: news.NewsService.DiffNewsRevisions:output_type -> news.DiffNewsRevisionsResponse
+	42, // 59: news.NewsService.RollbackNews:output_type -> news.RollbackNewsResponse
+	43, // [43:60] is the sub-list for method output_type
+	26, // [26:43] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_proto_news_news_proto_init() }
@@ -741,13 +2990,14 @@ func file_proto_news_news_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_news_news_proto_rawDesc), len(file_proto_news_news_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_news_news_proto_goTypes,
 		DependencyIndexes: file_proto_news_news_proto_depIdxs,
+		EnumInfos:         file_proto_news_news_proto_enumTypes,
 		MessageInfos:      file_proto_news_news_proto_msgTypes,
 	}.Build()
 	File_proto_news_news_proto = out.File
@@ -19,11 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NewsService_CreateNews_FullMethodName  = "/news.NewsService/CreateNews"
-	NewsService_GetNews_FullMethodName     = "/news.NewsService/GetNews"
-	NewsService_GetNewsList_FullMethodName = "/news.NewsService/GetNewsList"
-	NewsService_UpdateNews_FullMethodName  = "/news.NewsService/UpdateNews"
-	NewsService_DeleteNews_FullMethodName  = "/news.NewsService/DeleteNews"
+	NewsService_CreateNews_FullMethodName        = "/news.NewsService/CreateNews"
+	NewsService_GetNews_FullMethodName           = "/news.NewsService/GetNews"
+	NewsService_GetNewsList_FullMethodName       = "/news.NewsService/GetNewsList"
+	NewsService_UpdateNews_FullMethodName        = "/news.NewsService/UpdateNews"
+	NewsService_DeleteNews_FullMethodName        = "/news.NewsService/DeleteNews"
+	NewsService_RenameNews_FullMethodName        = "/news.NewsService/RenameNews"
+	NewsService_RestoreNews_FullMethodName       = "/news.NewsService/RestoreNews"
+	NewsService_ListDeletedNews_FullMethodName   = "/news.NewsService/ListDeletedNews"
+	NewsService_SearchNews_FullMethodName        = "/news.NewsService/SearchNews"
+	NewsService_SetNewsTags_FullMethodName       = "/news.NewsService/SetNewsTags"
+	NewsService_ListTags_FullMethodName          = "/news.NewsService/ListTags"
+	NewsService_PublishNews_FullMethodName       = "/news.NewsService/PublishNews"
+	NewsService_UnpublishNews_FullMethodName     = "/news.NewsService/UnpublishNews"
+	NewsService_ListNewsRevisions_FullMethodName = "/news.NewsService/ListNewsRevisions"
+	NewsService_GetNewsRevision_FullMethodName   = "/news.NewsService/GetNewsRevision"
+	NewsService_DiffNewsRevisions_FullMethodName = "/news.NewsService/DiffNewsRevisions"
+	NewsService_RollbackNews_FullMethodName      = "/news.NewsService/RollbackNews"
 )
 
 // NewsServiceClient is the client API for NewsService service.
@@ -35,6 +47,18 @@ type NewsServiceClient interface {
 	GetNewsList(ctx context.Context, in *GetNewsListRequest, opts ...grpc.CallOption) (*GetNewsListResponse, error)
 	UpdateNews(ctx context.Context, in *UpdateNewsRequest, opts ...grpc.CallOption) (*UpdateNewsResponse, error)
 	DeleteNews(ctx context.Context, in *DeleteNewsRequest, opts ...grpc.CallOption) (*DeleteNewsResponse, error)
+	RenameNews(ctx context.Context, in *RenameNewsRequest, opts ...grpc.CallOption) (*RenameNewsResponse, error)
+	RestoreNews(ctx context.Context, in *RestoreNewsRequest, opts ...grpc.CallOption) (*RestoreNewsResponse, error)
+	ListDeletedNews(ctx context.Context, in *ListDeletedNewsRequest, opts ...grpc.CallOption) (*ListDeletedNewsResponse, error)
+	SearchNews(ctx context.Context, in *SearchNewsRequest, opts ...grpc.CallOption) (*SearchNewsResponse, error)
+	SetNewsTags(ctx context.Context, in *SetNewsTagsRequest, opts ...grpc.CallOption) (*SetNewsTagsResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	PublishNews(ctx context.Context, in *PublishNewsRequest, opts ...grpc.CallOption) (*PublishNewsResponse, error)
+	UnpublishNews(ctx context.Context, in *UnpublishNewsRequest, opts ...grpc.CallOption) (*UnpublishNewsResponse, error)
+	ListNewsRevisions(ctx context.Context, in *ListNewsRevisionsRequest, opts ...grpc.CallOption) (*ListNewsRevisionsResponse, error)
+	GetNewsRevision(ctx context.Context, in *GetNewsRevisionRequest, opts ...grpc.CallOption) (*GetNewsRevisionResponse, error)
+	DiffNewsRevisions(ctx context.Context, in *DiffNewsRevisionsRequest, opts ...grpc.CallOption) (*DiffNewsRevisionsResponse, error)
+	RollbackNews(ctx context.Context, in *RollbackNewsRequest, opts ...grpc.CallOption) (*RollbackNewsResponse, error)
 }
 
 type newsServiceClient struct {
@@ -95,6 +119,126 @@ func (c *newsServiceClient) DeleteNews(ctx context.Context, in *DeleteNewsReques
 	return out, nil
 }
 
+func (c *newsServiceClient) RenameNews(ctx context.Context, in *RenameNewsRequest, opts ...grpc.CallOption) (*RenameNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_RenameNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) RestoreNews(ctx context.Context, in *RestoreNewsRequest, opts ...grpc.CallOption) (*RestoreNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_RestoreNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) ListDeletedNews(ctx context.Context, in *ListDeletedNewsRequest, opts ...grpc.CallOption) (*ListDeletedNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_ListDeletedNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) SearchNews(ctx context.Context, in *SearchNewsRequest, opts ...grpc.CallOption) (*SearchNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_SearchNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) SetNewsTags(ctx context.Context, in *SetNewsTagsRequest, opts ...grpc.CallOption) (*SetNewsTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNewsTagsResponse)
+	err := c.cc.Invoke(ctx, NewsService_SetNewsTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, NewsService_ListTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) PublishNews(ctx context.Context, in *PublishNewsRequest, opts ...grpc.CallOption) (*PublishNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_PublishNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) UnpublishNews(ctx context.Context, in *UnpublishNewsRequest, opts ...grpc.CallOption) (*UnpublishNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_UnpublishNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) ListNewsRevisions(ctx context.Context, in *ListNewsRevisionsRequest, opts ...grpc.CallOption) (*ListNewsRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNewsRevisionsResponse)
+	err := c.cc.Invoke(ctx, NewsService_ListNewsRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) GetNewsRevision(ctx context.Context, in *GetNewsRevisionRequest, opts ...grpc.CallOption) (*GetNewsRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNewsRevisionResponse)
+	err := c.cc.Invoke(ctx, NewsService_GetNewsRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) DiffNewsRevisions(ctx context.Context, in *DiffNewsRevisionsRequest, opts ...grpc.CallOption) (*DiffNewsRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffNewsRevisionsResponse)
+	err := c.cc.Invoke(ctx, NewsService_DiffNewsRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *newsServiceClient) RollbackNews(ctx context.Context, in *RollbackNewsRequest, opts ...grpc.CallOption) (*RollbackNewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollbackNewsResponse)
+	err := c.cc.Invoke(ctx, NewsService_RollbackNews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NewsServiceServer is the server API for NewsService service.
 // All implementations must embed UnimplementedNewsServiceServer
 // for forward compatibility.
@@ -104,6 +248,18 @@ type NewsServiceServer interface {
 	GetNewsList(context.Context, *GetNewsListRequest) (*GetNewsListResponse, error)
 	UpdateNews(context.Context, *UpdateNewsRequest) (*UpdateNewsResponse, error)
 	DeleteNews(context.Context, *DeleteNewsRequest) (*DeleteNewsResponse, error)
+	RenameNews(context.Context, *RenameNewsRequest) (*RenameNewsResponse, error)
+	RestoreNews(context.Context, *RestoreNewsRequest) (*RestoreNewsResponse, error)
+	ListDeletedNews(context.Context, *ListDeletedNewsRequest) (*ListDeletedNewsResponse, error)
+	SearchNews(context.Context, *SearchNewsRequest) (*SearchNewsResponse, error)
+	SetNewsTags(context.Context, *SetNewsTagsRequest) (*SetNewsTagsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	PublishNews(context.Context, *PublishNewsRequest) (*PublishNewsResponse, error)
+	UnpublishNews(context.Context, *UnpublishNewsRequest) (*UnpublishNewsResponse, error)
+	ListNewsRevisions(context.Context, *ListNewsRevisionsRequest) (*ListNewsRevisionsResponse, error)
+	GetNewsRevision(context.Context, *GetNewsRevisionRequest) (*GetNewsRevisionResponse, error)
+	DiffNewsRevisions(context.Context, *DiffNewsRevisionsRequest) (*DiffNewsRevisionsResponse, error)
+	RollbackNews(context.Context, *RollbackNewsRequest) (*RollbackNewsResponse, error)
 	mustEmbedUnimplementedNewsServiceServer()
 }
 
@@ -129,6 +285,42 @@ func (UnimplementedNewsServiceServer) UpdateNews(context.Context, *UpdateNewsReq
 func (UnimplementedNewsServiceServer) DeleteNews(context.Context, *DeleteNewsRequest) (*DeleteNewsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteNews not implemented")
 }
+func (UnimplementedNewsServiceServer) RenameNews(context.Context, *RenameNewsRequest) (*RenameNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameNews not implemented")
+}
+func (UnimplementedNewsServiceServer) RestoreNews(context.Context, *RestoreNewsRequest) (*RestoreNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreNews not implemented")
+}
+func (UnimplementedNewsServiceServer) ListDeletedNews(context.Context, *ListDeletedNewsRequest) (*ListDeletedNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedNews not implemented")
+}
+func (UnimplementedNewsServiceServer) SearchNews(context.Context, *SearchNewsRequest) (*SearchNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchNews not implemented")
+}
+func (UnimplementedNewsServiceServer) SetNewsTags(context.Context, *SetNewsTagsRequest) (*SetNewsTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNewsTags not implemented")
+}
+func (UnimplementedNewsServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedNewsServiceServer) PublishNews(context.Context, *PublishNewsRequest) (*PublishNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishNews not implemented")
+}
+func (UnimplementedNewsServiceServer) UnpublishNews(context.Context, *UnpublishNewsRequest) (*UnpublishNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpublishNews not implemented")
+}
+func (UnimplementedNewsServiceServer) ListNewsRevisions(context.Context, *ListNewsRevisionsRequest) (*ListNewsRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNewsRevisions not implemented")
+}
+func (UnimplementedNewsServiceServer) GetNewsRevision(context.Context, *GetNewsRevisionRequest) (*GetNewsRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNewsRevision not implemented")
+}
+func (UnimplementedNewsServiceServer) DiffNewsRevisions(context.Context, *DiffNewsRevisionsRequest) (*DiffNewsRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffNewsRevisions not implemented")
+}
+func (UnimplementedNewsServiceServer) RollbackNews(context.Context, *RollbackNewsRequest) (*RollbackNewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackNews not implemented")
+}
 func (UnimplementedNewsServiceServer) mustEmbedUnimplementedNewsServiceServer() {}
 func (UnimplementedNewsServiceServer) testEmbeddedByValue()                     {}
 
@@ -240,6 +432,222 @@ func _NewsService_DeleteNews_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NewsService_RenameNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).RenameNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_RenameNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).RenameNews(ctx, req.(*RenameNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_RestoreNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).RestoreNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_RestoreNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).RestoreNews(ctx, req.(*RestoreNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_ListDeletedNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).ListDeletedNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_ListDeletedNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).ListDeletedNews(ctx, req.(*ListDeletedNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_SearchNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).SearchNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_SearchNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).SearchNews(ctx, req.(*SearchNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_SetNewsTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNewsTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).SetNewsTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_SetNewsTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).SetNewsTags(ctx, req.(*SetNewsTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_PublishNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).PublishNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_PublishNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).PublishNews(ctx, req.(*PublishNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_UnpublishNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).UnpublishNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_UnpublishNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).UnpublishNews(ctx, req.(*UnpublishNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_ListNewsRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNewsRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).ListNewsRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_ListNewsRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).ListNewsRevisions(ctx, req.(*ListNewsRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_GetNewsRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNewsRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).GetNewsRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_GetNewsRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).GetNewsRevision(ctx, req.(*GetNewsRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_DiffNewsRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffNewsRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).DiffNewsRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_DiffNewsRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).DiffNewsRevisions(ctx, req.(*DiffNewsRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NewsService_RollbackNews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackNewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NewsServiceServer).RollbackNews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NewsService_RollbackNews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NewsServiceServer).RollbackNews(ctx, req.(*RollbackNewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NewsService_ServiceDesc is the grpc.ServiceDesc for NewsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +675,54 @@ var NewsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteNews",
 			Handler:    _NewsService_DeleteNews_Handler,
 		},
+		{
+			MethodName: "RenameNews",
+			Handler:    _NewsService_RenameNews_Handler,
+		},
+		{
+			MethodName: "RestoreNews",
+			Handler:    _NewsService_RestoreNews_Handler,
+		},
+		{
+			MethodName: "ListDeletedNews",
+			Handler:    _NewsService_ListDeletedNews_Handler,
+		},
+		{
+			MethodName: "SearchNews",
+			Handler:    _NewsService_SearchNews_Handler,
+		},
+		{
+			MethodName: "SetNewsTags",
+			Handler:    _NewsService_SetNewsTags_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _NewsService_ListTags_Handler,
+		},
+		{
+			MethodName: "PublishNews",
+			Handler:    _NewsService_PublishNews_Handler,
+		},
+		{
+			MethodName: "UnpublishNews",
+			Handler:    _NewsService_UnpublishNews_Handler,
+		},
+		{
+			MethodName: "ListNewsRevisions",
+			Handler:    _NewsService_ListNewsRevisions_Handler,
+		},
+		{
+			MethodName: "GetNewsRevision",
+			Handler:    _NewsService_GetNewsRevision_Handler,
+		},
+		{
+			MethodName: "DiffNewsRevisions",
+			Handler:    _NewsService_DiffNewsRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackNews",
+			Handler:    _NewsService_RollbackNews_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/news/news.proto",